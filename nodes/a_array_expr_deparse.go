@@ -2,6 +2,16 @@
 
 package pg_query
 
+import (
+	"fmt"
+	"strings"
+)
+
 func (node A_ArrayExpr) Deparse(ctx Context) (*string, error) {
-	panic("Not Implemented")
+	if elements, err := node.Elements.DeparseList(Context_None); err != nil {
+		return nil, err
+	} else {
+		result := fmt.Sprintf("ARRAY[%s]", strings.Join(elements, ", "))
+		return &result, nil
+	}
 }
@@ -9,12 +9,33 @@ import (
 	"strings"
 )
 
+var (
+	patternOperators = map[string]string{
+		"~~":   "LIKE",
+		"!~~":  "NOT LIKE",
+		"~~*":  "ILIKE",
+		"!~~*": "NOT ILIKE",
+		"~":    "SIMILAR TO",
+		"!~":   "NOT SIMILAR TO",
+	}
+)
+
 func (node A_Expr) Deparse(ctx Context) (*string, error) {
 	switch node.Kind {
 	case AEXPR_OP:
 		return node.deparseAexpr(ctx)
 	case AEXPR_OP_ANY:
 		return node.deparseAexprAny(ctx)
+	case AEXPR_OP_ALL:
+		return node.deparseAexprAll(ctx)
+	case AEXPR_DISTINCT, AEXPR_NOT_DISTINCT:
+		return node.deparseAexprDistinct(ctx)
+	case AEXPR_OF:
+		return node.deparseAexprOf(ctx)
+	case AEXPR_LIKE, AEXPR_ILIKE, AEXPR_SIMILAR:
+		return node.deparseAexprPattern(ctx)
+	case AEXPR_PAREN:
+		return node.deparseAexprParen(ctx)
 	case AEXPR_IN:
 		return node.deparseAexprIn(ctx)
 	case AEXPR_BETWEEN, AEXPR_NOT_BETWEEN, AEXPR_BETWEEN_SYM, AEXPR_NOT_BETWEEN_SYM:
@@ -60,10 +81,20 @@ func (node A_Expr) deparseAexpr(ctx Context) (*string, error) {
 		return nil, errors.New("error, expression name cannot be null")
 	}
 
-	if name, err := deparseNode(node.Name.Items[0], Context_Operator); err != nil {
+	if name, err := deparseOperatorName(node.Name); err != nil {
 		return nil, err
 	} else {
-		result := strings.Join(out, fmt.Sprintf(" %s ", *name))
+		result := ""
+		switch {
+		case node.Lexpr == nil:
+			// Prefix operators such as unary minus. The space keeps two minus
+			// signs in a row from being read back as a comment.
+			result = fmt.Sprintf("%s %s", *name, strings.Join(out, ""))
+		case node.Rexpr == nil:
+			result = fmt.Sprintf("%s %s", strings.Join(out, ""), *name)
+		default:
+			result = strings.Join(out, fmt.Sprintf(" %s ", *name))
+		}
 		if ctx != Context_None {
 			result = fmt.Sprintf("(%s)", result)
 		}
@@ -181,3 +212,149 @@ func (node A_Expr) deparseAExprNullIf(ctx Context) (*string, error) {
 	result := fmt.Sprintf("NULLIF(%s, %s)", *leftString, *rightString)
 	return &result, nil
 }
+
+// wrapAexpr parenthesizes the expression when it is deparsed as part of
+// another expression, just like operator expressions are.
+func wrapAexpr(result string, ctx Context) *string {
+	if ctx != Context_None {
+		result = fmt.Sprintf("(%s)", result)
+	}
+	return &result
+}
+
+func (node A_Expr) deparseAexprAll(ctx Context) (*string, error) {
+	out := make([]string, 0)
+	if str, err := deparseNode(node.Lexpr, Context_None); err != nil {
+		return nil, err
+	} else {
+		out = append(out, *str)
+	}
+
+	if str, err := node.Rexpr.Deparse(Context_None); err != nil {
+		return nil, err
+	} else {
+		out = append(out, fmt.Sprintf("ALL(%s)", *str))
+	}
+
+	if str, err := deparseOperatorName(node.Name); err != nil {
+		return nil, err
+	} else {
+		result := strings.Join(out, *str)
+		return &result, nil
+	}
+}
+
+func (node A_Expr) deparseAexprDistinct(ctx Context) (*string, error) {
+	left, err := deparseNode(node.Lexpr, Context_None)
+	if err != nil {
+		return nil, err
+	}
+
+	right, err := deparseNode(node.Rexpr, Context_None)
+	if err != nil {
+		return nil, err
+	}
+
+	distinct := "IS DISTINCT FROM"
+	if node.Kind == AEXPR_NOT_DISTINCT {
+		distinct = "IS NOT DISTINCT FROM"
+	}
+
+	return wrapAexpr(fmt.Sprintf("%s %s %s", *left, distinct, *right), ctx), nil
+}
+
+func (node A_Expr) deparseAexprOf(ctx Context) (*string, error) {
+	left, err := deparseNode(node.Lexpr, Context_None)
+	if err != nil {
+		return nil, err
+	}
+
+	types, ok := node.Rexpr.(List)
+	if !ok {
+		return nil, errors.New("rexpr of IS OF expression must be a list of types")
+	}
+
+	names, err := types.DeparseList(Context_None)
+	if err != nil {
+		return nil, err
+	}
+
+	operator, err := deparseOperatorName(node.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	of := "IS OF"
+	if *operator == "<>" {
+		of = "IS NOT OF"
+	}
+
+	return wrapAexpr(fmt.Sprintf("%s %s (%s)", *left, of, strings.Join(names, ", ")), ctx), nil
+}
+
+func (node A_Expr) deparseAexprPattern(ctx Context) (*string, error) {
+	operator, err := deparseOperatorName(node.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	keyword, ok := patternOperators[*operator]
+	if !ok {
+		return nil, errors.Errorf("cannot deparse pattern operator %s", *operator)
+	}
+
+	out := make([]string, 0)
+	if str, err := deparseNode(node.Lexpr, Context_None); err != nil {
+		return nil, err
+	} else {
+		out = append(out, *str, keyword)
+	}
+
+	// The parser wraps the pattern in a call to like_escape or similar_escape
+	// when an ESCAPE clause is given, SIMILAR TO always uses similar_escape.
+	pattern, escape := node.Rexpr, Node(nil)
+	if call, ok := node.Rexpr.(FuncCall); ok && len(call.Args.Items) == 2 {
+		if names, err := call.Funcname.DeparseList(Context_FuncCall); err == nil {
+			name := strings.Join(names, ".")
+			if name == "pg_catalog.like_escape" || name == "pg_catalog.similar_escape" {
+				pattern, escape = call.Args.Items[0], call.Args.Items[1]
+			}
+		}
+	}
+
+	if str, err := deparseNode(pattern, Context_None); err != nil {
+		return nil, err
+	} else {
+		out = append(out, *str)
+	}
+
+	if escape != nil {
+		if constant, ok := escape.(A_Const); !ok || !isNull(constant.Val) {
+			if str, err := deparseNode(escape, Context_None); err != nil {
+				return nil, err
+			} else {
+				out = append(out, "ESCAPE", *str)
+			}
+		}
+	}
+
+	return wrapAexpr(strings.Join(out, " "), ctx), nil
+}
+
+func (node A_Expr) deparseAexprParen(ctx Context) (*string, error) {
+	if node.Lexpr == nil {
+		return nil, errors.New("lexpr of parenthesized expression cannot be null")
+	}
+
+	if str, err := deparseNode(node.Lexpr, Context_None); err != nil {
+		return nil, err
+	} else {
+		result := fmt.Sprintf("(%s)", *str)
+		return &result, nil
+	}
+}
+
+func isNull(node Node) bool {
+	_, ok := node.(Null)
+	return ok
+}
@@ -0,0 +1,90 @@
+/*
+ * Copyright (c) 2019 Ready Stock
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+package pg_query
+
+import (
+	"testing"
+)
+
+func Test_AExpr_Like(t *testing.T) {
+	DoTest(t, DeparseTest{
+		Query:    `SELECT * FROM users WHERE email LIKE '%@example.com';`,
+		Expected: `SELECT * FROM "users" WHERE "email" LIKE '%@example.com'`,
+	})
+	DoTest(t, DeparseTest{
+		Query:    `SELECT * FROM users WHERE email NOT ILIKE 'admin%';`,
+		Expected: `SELECT * FROM "users" WHERE "email" NOT ILIKE 'admin%'`,
+	})
+	DoTest(t, DeparseTest{
+		Query:    `SELECT * FROM users WHERE name LIKE '50!%%' ESCAPE '!';`,
+		Expected: `SELECT * FROM "users" WHERE "name" LIKE '50!%%' ESCAPE '!'`,
+	})
+	DoTest(t, DeparseTest{
+		Query:    `SELECT * FROM users WHERE name NOT ILIKE '50!%%' ESCAPE '!';`,
+		Expected: `SELECT * FROM "users" WHERE "name" NOT ILIKE '50!%%' ESCAPE '!'`,
+	})
+}
+
+func Test_AExpr_Similar(t *testing.T) {
+	DoTest(t, DeparseTest{
+		Query:    `SELECT 'abc' SIMILAR TO '%(b|d)%';`,
+		Expected: `SELECT 'abc' SIMILAR TO '%(b|d)%'`,
+	})
+	DoTest(t, DeparseTest{
+		Query:    `SELECT 'abc' NOT SIMILAR TO '%#"b_#"%' ESCAPE '#';`,
+		Expected: `SELECT 'abc' NOT SIMILAR TO '%#"b_#"%' ESCAPE '#'`,
+	})
+}
+
+func Test_AExpr_Distinct(t *testing.T) {
+	DoTest(t, DeparseTest{
+		Query:    `SELECT * FROM users WHERE deleted_at IS DISTINCT FROM NULL;`,
+		Expected: `SELECT * FROM "users" WHERE "deleted_at" IS DISTINCT FROM NULL`,
+	})
+	DoTest(t, DeparseTest{
+		Query:    `SELECT * FROM users WHERE a IS NOT DISTINCT FROM b AND NOT c IS DISTINCT FROM d;`,
+		Expected: `SELECT * FROM "users" WHERE "a" IS NOT DISTINCT FROM "b" AND NOT ("c" IS DISTINCT FROM "d")`,
+	})
+}
+
+func Test_AExpr_All(t *testing.T) {
+	DoTest(t, DeparseTest{
+		Query:    `SELECT * FROM users WHERE id <> ALL(blocked_ids);`,
+		Expected: `SELECT * FROM "users" WHERE "id"<>ALL("blocked_ids")`,
+	})
+}
+
+func Test_AExpr_Of(t *testing.T) {
+	DoTest(t, DeparseTest{
+		Query:    `SELECT a IS OF (text, varchar), a IS NOT OF (text) FROM t;`,
+		Expected: `SELECT "a" IS OF (text, varchar), "a" IS NOT OF (text) FROM "t"`,
+	})
+}
+
+func Test_AExpr_PrefixOperator(t *testing.T) {
+	DoTest(t, DeparseTest{
+		Query:    `SELECT -x, ~a, - -x FROM t;`,
+		Expected: `SELECT - "x", ~ "a", - - "x" FROM "t"`,
+	})
+}
+
+func Test_AExpr_QualifiedOperator(t *testing.T) {
+	DoTest(t, DeparseTest{
+		Query:    `SELECT a OPERATOR(pg_catalog.+) b FROM t;`,
+		Expected: `SELECT "a" OPERATOR(pg_catalog.+) "b" FROM "t"`,
+	})
+}
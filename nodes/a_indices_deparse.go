@@ -2,6 +2,33 @@
 
 package pg_query
 
+import (
+	"fmt"
+)
+
 func (node A_Indices) Deparse(ctx Context) (*string, error) {
-	panic("Not Implemented")
+	lower, upper := "", ""
+	if node.Lidx != nil {
+		if str, err := deparseNode(node.Lidx, Context_None); err != nil {
+			return nil, err
+		} else {
+			lower = *str
+		}
+	}
+
+	if node.Uidx != nil {
+		if str, err := deparseNode(node.Uidx, Context_None); err != nil {
+			return nil, err
+		} else {
+			upper = *str
+		}
+	}
+
+	// Either bound of a slice can be omitted, which then defaults to the bound
+	// of the array itself.
+	result := fmt.Sprintf("[%s]", upper)
+	if node.IsSlice {
+		result = fmt.Sprintf("[%s:%s]", lower, upper)
+	}
+	return &result, nil
 }
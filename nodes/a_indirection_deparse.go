@@ -2,6 +2,56 @@
 
 package pg_query
 
+import (
+	"fmt"
+	"strings"
+)
+
 func (node A_Indirection) Deparse(ctx Context) (*string, error) {
-	panic("Not Implemented")
+	arg, err := deparseNode(node.Arg, Context_None)
+	if err != nil {
+		return nil, err
+	}
+
+	indirection, err := deparseIndirection(node.Indirection)
+	if err != nil {
+		return nil, err
+	}
+
+	// Column references and parameters can be subscripted directly, anything
+	// else has to be parenthesized. Selecting a field from a column has to be
+	// parenthesized too, otherwise it would be read as a qualified column.
+	switch node.Arg.(type) {
+	case ParamRef:
+	case ColumnRef:
+		if _, ok := node.Indirection.Items[0].(A_Indices); !ok {
+			*arg = fmt.Sprintf("(%s)", *arg)
+		}
+	default:
+		*arg = fmt.Sprintf("(%s)", *arg)
+	}
+
+	result := fmt.Sprintf("%s%s", *arg, *indirection)
+	return &result, nil
+}
+
+// deparseIndirection returns the subscripts and field selections that are
+// applied to an expression or the target of an assignment.
+func deparseIndirection(indirection List) (*string, error) {
+	out := make([]string, len(indirection.Items))
+	for i, item := range indirection.Items {
+		str, err := deparseNode(item, Context_None)
+		if err != nil {
+			return nil, err
+		}
+
+		if _, ok := item.(A_Indices); ok {
+			out[i] = *str
+		} else {
+			out[i] = fmt.Sprintf(".%s", *str)
+		}
+	}
+
+	result := strings.Join(out, "")
+	return &result, nil
 }
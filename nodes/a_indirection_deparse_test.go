@@ -0,0 +1,56 @@
+/*
+ * Copyright (c) 2019 Ready Stock
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+package pg_query
+
+import (
+	"testing"
+)
+
+func Test_A_ArrayExpr(t *testing.T) {
+	DoTest(t, DeparseTest{
+		Query:    `SELECT ARRAY[1, 2, 3], ARRAY[ARRAY[1, 2], ARRAY[3, 4]], ARRAY[]::int[];`,
+		Expected: `SELECT ARRAY[1, 2, 3], ARRAY[ARRAY[1, 2], ARRAY[3, 4]], ARRAY[]::int[]`,
+	})
+}
+
+func Test_A_Indirection_Subscript(t *testing.T) {
+	DoTest(t, DeparseTest{
+		Query:    `SELECT a[1], b[1:3], c[:2], d[2:][1] FROM arrtest;`,
+		Expected: `SELECT "a"[1], "b"[1:3], "c"[:2], "d"[2:][1] FROM "arrtest"`,
+	})
+}
+
+func Test_A_Indirection_Field(t *testing.T) {
+	DoTest(t, DeparseTest{
+		Query:    `SELECT (rec).field, (rec).*, (f(x)).a, (ARRAY[1, 2])[1], $1.f FROM t;`,
+		Expected: `SELECT ("rec")."field", ("rec").*, (f("x"))."a", (ARRAY[1, 2])[1], $1."f" FROM "t"`,
+	})
+}
+
+func Test_A_Indirection_Assignment(t *testing.T) {
+	DoTest(t, DeparseTest{
+		Query:    `UPDATE arrtest SET a[1:2] = '{16,25}', c.f = 1, (b[1], d) = (2, 3);`,
+		Expected: `UPDATE "arrtest" SET a[1:2] = '{16,25}', c."f" = 1, (b[1], d) = (2, 3)`,
+	})
+}
+
+func Test_A_Indirection_Insert(t *testing.T) {
+	DoTest(t, DeparseTest{
+		Query:    `INSERT INTO arrtest (a[1], b.f) VALUES (1, DEFAULT);`,
+		Expected: `INSERT INTO "arrtest" (a[1], b."f") VALUES (1, DEFAULT)`,
+	})
+}
@@ -2,6 +2,29 @@
 
 package pg_query
 
+import (
+	"fmt"
+	"strings"
+)
+
 func (node AccessPriv) Deparse(ctx Context) (*string, error) {
-	panic("Not Implemented")
+	out := make([]string, 0)
+
+	// A privilege without a name indicates ALL PRIVILEGES on a list of columns.
+	if node.PrivName != nil {
+		out = append(out, strings.ToUpper(*node.PrivName))
+	} else {
+		out = append(out, "ALL")
+	}
+
+	if node.Cols.Items != nil && len(node.Cols.Items) > 0 {
+		if cols, err := node.Cols.DeparseList(Context_None); err != nil {
+			return nil, err
+		} else {
+			out = append(out, fmt.Sprintf("(%s)", strings.Join(cols, ", ")))
+		}
+	}
+
+	result := strings.Join(out, " ")
+	return &result, nil
 }
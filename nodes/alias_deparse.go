@@ -5,18 +5,25 @@ package pg_query
 import (
 	"fmt"
 	"strings"
+
+	"github.com/juju/errors"
 )
 
 func (node Alias) Deparse(ctx Context) (*string, error) {
+	if node.Aliasname == nil {
+		return nil, errors.New("alias name cannot be null")
+	}
+
+	name := quoteIdentifier(*node.Aliasname)
 	if node.Colnames.Items != nil && len(node.Colnames.Items) > 0 {
 		if colnames, err := deparseNodeList(node.Colnames.Items, Context_None); err != nil {
 			return nil, err
 		} else {
 			cols := strings.Join(colnames, ", ")
-			result := fmt.Sprintf(`%s (%s)`, *node.Aliasname, cols)
+			result := fmt.Sprintf(`%s (%s)`, name, cols)
 			return &result, nil
 		}
 	} else {
-		return node.Aliasname, nil
+		return &name, nil
 	}
 }
@@ -2,6 +2,20 @@
 
 package pg_query
 
+import (
+	"strings"
+)
+
 func (node AlterCollationStmt) Deparse(ctx Context) (*string, error) {
-	panic("Not Implemented")
+	out := []string{"ALTER COLLATION"}
+	if name, err := node.Collname.DeparseList(Context_None); err != nil {
+		return nil, err
+	} else {
+		out = append(out, strings.Join(name, "."))
+	}
+
+	out = append(out, "REFRESH VERSION")
+
+	result := strings.Join(out, " ")
+	return &result, nil
 }
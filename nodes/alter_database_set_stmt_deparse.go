@@ -2,6 +2,29 @@
 
 package pg_query
 
+import (
+	"strings"
+
+	"github.com/juju/errors"
+)
+
 func (node AlterDatabaseSetStmt) Deparse(ctx Context) (*string, error) {
-	panic("Not Implemented")
+	out := []string{"ALTER DATABASE"}
+	if node.Dbname == nil {
+		return nil, errors.New("database name cannot be null in alter database")
+	}
+	out = append(out, quoteIdentifier(*node.Dbname))
+
+	if node.Setstmt == nil {
+		return nil, errors.New("alter database set statement must have a set statement")
+	}
+
+	if str, err := deparseNode(*node.Setstmt, Context_None); err != nil {
+		return nil, err
+	} else {
+		out = append(out, *str)
+	}
+
+	result := strings.Join(out, " ")
+	return &result, nil
 }
@@ -2,6 +2,29 @@
 
 package pg_query
 
+import (
+	"strings"
+
+	"github.com/juju/errors"
+)
+
 func (node AlterDatabaseStmt) Deparse(ctx Context) (*string, error) {
-	panic("Not Implemented")
+	out := []string{"ALTER DATABASE"}
+	if node.Dbname == nil {
+		return nil, errors.New("database name cannot be null in alter database")
+	}
+	out = append(out, quoteIdentifier(*node.Dbname))
+
+	if node.Options.Items == nil || len(node.Options.Items) == 0 {
+		return nil, errors.New("alter database statement must have at least one option")
+	}
+
+	if options, err := deparseCreatedbOptions(node.Options); err != nil {
+		return nil, err
+	} else {
+		out = append(out, "WITH", *options)
+	}
+
+	result := strings.Join(out, " ")
+	return &result, nil
 }
@@ -2,6 +2,50 @@
 
 package pg_query
 
+import (
+	"github.com/juju/errors"
+	"strings"
+)
+
 func (node AlterDefaultPrivilegesStmt) Deparse(ctx Context) (*string, error) {
-	panic("Not Implemented")
+	out := []string{"ALTER DEFAULT PRIVILEGES"}
+
+	for _, item := range node.Options.Items {
+		option, ok := item.(DefElem)
+		if !ok || option.Defname == nil {
+			return nil, errors.Errorf("cannot deparse default privileges option of type %T", item)
+		}
+
+		values, ok := option.Arg.(List)
+		if !ok {
+			return nil, errors.Errorf("default privileges option %s must be a list", *option.Defname)
+		}
+
+		names, err := values.DeparseList(Context_None)
+		if err != nil {
+			return nil, err
+		}
+
+		switch *option.Defname {
+		case "roles":
+			out = append(out, "FOR ROLE", strings.Join(names, ", "))
+		case "schemas":
+			out = append(out, "IN SCHEMA", strings.Join(names, ", "))
+		default:
+			return nil, errors.Errorf("cannot deparse default privileges option: %s", *option.Defname)
+		}
+	}
+
+	if node.Action == nil {
+		return nil, errors.New("alter default privileges must have an action")
+	}
+
+	if str, err := deparseNode(*node.Action, Context_None); err != nil {
+		return nil, err
+	} else {
+		out = append(out, *str)
+	}
+
+	result := strings.Join(out, " ")
+	return &result, nil
 }
@@ -2,6 +2,58 @@
 
 package pg_query
 
+import (
+	"strings"
+
+	"github.com/juju/errors"
+)
+
 func (node AlterDomainStmt) Deparse(ctx Context) (*string, error) {
-	panic("Not Implemented")
+	out := []string{"ALTER DOMAIN"}
+	if names, err := node.TypeName.DeparseList(Context_None); err != nil {
+		return nil, err
+	} else {
+		out = append(out, strings.Join(names, "."))
+	}
+
+	if (node.Subtype == 'X' || node.Subtype == 'V') && node.Name == nil {
+		return nil, errors.New("constraint name cannot be null in alter domain")
+	}
+
+	switch node.Subtype {
+	case 'T':
+		if node.Def == nil {
+			out = append(out, "DROP DEFAULT")
+		} else if str, err := deparseNode(node.Def, Context_None); err != nil {
+			return nil, err
+		} else {
+			out = append(out, "SET DEFAULT", *str)
+		}
+	case 'N':
+		out = append(out, "DROP NOT NULL")
+	case 'O':
+		out = append(out, "SET NOT NULL")
+	case 'C':
+		if str, err := deparseNode(node.Def, Context_None); err != nil {
+			return nil, err
+		} else {
+			out = append(out, "ADD", *str)
+		}
+	case 'X':
+		out = append(out, "DROP CONSTRAINT")
+		if node.MissingOk {
+			out = append(out, "IF EXISTS")
+		}
+		out = append(out, quoteIdentifier(*node.Name))
+		if node.Behavior == DROP_CASCADE {
+			out = append(out, "CASCADE")
+		}
+	case 'V':
+		out = append(out, "VALIDATE CONSTRAINT", quoteIdentifier(*node.Name))
+	default:
+		return nil, errors.Errorf("cannot deparse alter domain subtype (%c)", node.Subtype)
+	}
+
+	result := strings.Join(out, " ")
+	return &result, nil
 }
@@ -2,6 +2,46 @@
 
 package pg_query
 
+import (
+	"strings"
+
+	"github.com/juju/errors"
+)
+
 func (node AlterEnumStmt) Deparse(ctx Context) (*string, error) {
-	panic("Not Implemented")
+	if node.NewVal == nil {
+		return nil, errors.New("new value cannot be null in alter type")
+	}
+
+	out := []string{"ALTER TYPE"}
+	if names, err := node.TypeName.DeparseList(Context_None); err != nil {
+		return nil, err
+	} else {
+		out = append(out, strings.Join(names, "."))
+	}
+
+	// Renaming a value is the only change that specifies the old value.
+	if node.OldVal != nil {
+		out = append(out, "RENAME VALUE", quoteLiteral(*node.OldVal), "TO", quoteLiteral(*node.NewVal))
+		result := strings.Join(out, " ")
+		return &result, nil
+	}
+
+	out = append(out, "ADD VALUE")
+	if node.SkipIfNewValExists {
+		out = append(out, "IF NOT EXISTS")
+	}
+	out = append(out, quoteLiteral(*node.NewVal))
+
+	if node.NewValNeighbor != nil {
+		if node.NewValIsAfter {
+			out = append(out, "AFTER")
+		} else {
+			out = append(out, "BEFORE")
+		}
+		out = append(out, quoteLiteral(*node.NewValNeighbor))
+	}
+
+	result := strings.Join(out, " ")
+	return &result, nil
 }
@@ -2,6 +2,34 @@
 
 package pg_query
 
+import (
+	"strings"
+
+	"github.com/juju/errors"
+)
+
+var (
+	eventTriggerFiringModes = map[byte]string{
+		'O': "ENABLE",
+		'R': "ENABLE REPLICA",
+		'A': "ENABLE ALWAYS",
+		'D': "DISABLE",
+	}
+)
+
 func (node AlterEventTrigStmt) Deparse(ctx Context) (*string, error) {
-	panic("Not Implemented")
+	if node.Trigname == nil {
+		return nil, errors.New("event trigger must have a name")
+	}
+
+	out := []string{"ALTER EVENT TRIGGER", quoteIdentifier(*node.Trigname)}
+
+	if mode, ok := eventTriggerFiringModes[node.Tgenabled]; !ok {
+		return nil, errors.Errorf("cannot deparse event trigger firing mode (%c)", node.Tgenabled)
+	} else {
+		out = append(out, mode)
+	}
+
+	result := strings.Join(out, " ")
+	return &result, nil
 }
@@ -2,6 +2,40 @@
 
 package pg_query
 
+import (
+	"strings"
+
+	"github.com/juju/errors"
+)
+
 func (node AlterExtensionContentsStmt) Deparse(ctx Context) (*string, error) {
-	panic("Not Implemented")
+	out := []string{"ALTER EXTENSION"}
+	if node.Extname == nil {
+		return nil, errors.New("extension name cannot be null in alter extension")
+	}
+	out = append(out, quoteIdentifier(*node.Extname))
+
+	switch node.Action {
+	case 1:
+		out = append(out, "ADD")
+	case -1:
+		out = append(out, "DROP")
+	default:
+		return nil, errors.Errorf("cannot deparse alter extension action (%d)", node.Action)
+	}
+
+	if objectType, err := deparseObjectType(node.Objtype); err != nil {
+		return nil, err
+	} else {
+		out = append(out, *objectType)
+	}
+
+	if str, err := deparseObjectName(node.Objtype, nil, node.Object); err != nil {
+		return nil, err
+	} else {
+		out = append(out, *str)
+	}
+
+	result := strings.Join(out, " ")
+	return &result, nil
 }
@@ -2,6 +2,33 @@
 
 package pg_query
 
+import (
+	"strings"
+
+	"github.com/juju/errors"
+)
+
 func (node AlterExtensionStmt) Deparse(ctx Context) (*string, error) {
-	panic("Not Implemented")
+	out := []string{"ALTER EXTENSION"}
+	if node.Extname == nil {
+		return nil, errors.New("extension name cannot be null in alter extension")
+	}
+	out = append(out, quoteIdentifier(*node.Extname), "UPDATE")
+
+	// Without a version the extension is updated to its default version.
+	for _, item := range node.Options.Items {
+		option, ok := item.(DefElem)
+		if !ok || option.Defname == nil || *option.Defname != "new_version" {
+			return nil, errors.New("alter extension can only update the version")
+		}
+
+		if str, err := option.deparseArg(); err != nil {
+			return nil, err
+		} else {
+			out = append(out, "TO", *str)
+		}
+	}
+
+	result := strings.Join(out, " ")
+	return &result, nil
 }
@@ -2,6 +2,33 @@
 
 package pg_query
 
+import (
+	"strings"
+
+	"github.com/juju/errors"
+)
+
 func (node AlterFdwStmt) Deparse(ctx Context) (*string, error) {
-	panic("Not Implemented")
+	if node.Fdwname == nil {
+		return nil, errors.New("foreign data wrapper name cannot be null in alter foreign data wrapper")
+	}
+
+	out := []string{"ALTER FOREIGN DATA WRAPPER", quoteIdentifier(*node.Fdwname)}
+
+	if options, err := deparseFdwFuncOptions(node.FuncOptions); err != nil {
+		return nil, err
+	} else {
+		out = append(out, options...)
+	}
+
+	if node.Options.Items != nil && len(node.Options.Items) > 0 {
+		if str, err := deparseGenericOptions(node.Options); err != nil {
+			return nil, err
+		} else {
+			out = append(out, *str)
+		}
+	}
+
+	result := strings.Join(out, " ")
+	return &result, nil
 }
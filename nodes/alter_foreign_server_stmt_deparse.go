@@ -2,6 +2,36 @@
 
 package pg_query
 
+import (
+	"strings"
+
+	"github.com/juju/errors"
+)
+
 func (node AlterForeignServerStmt) Deparse(ctx Context) (*string, error) {
-	panic("Not Implemented")
+	if node.Servername == nil {
+		return nil, errors.New("server name cannot be null in alter server")
+	}
+
+	out := []string{"ALTER SERVER", quoteIdentifier(*node.Servername)}
+
+	// The version can be removed as well, in which case it is set to NULL.
+	if node.HasVersion {
+		if node.Version != nil {
+			out = append(out, "VERSION", quoteLiteral(*node.Version))
+		} else {
+			out = append(out, "VERSION NULL")
+		}
+	}
+
+	if node.Options.Items != nil && len(node.Options.Items) > 0 {
+		if str, err := deparseGenericOptions(node.Options); err != nil {
+			return nil, err
+		} else {
+			out = append(out, *str)
+		}
+	}
+
+	result := strings.Join(out, " ")
+	return &result, nil
 }
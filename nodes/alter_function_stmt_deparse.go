@@ -2,6 +2,36 @@
 
 package pg_query
 
+import (
+	"github.com/juju/errors"
+	"strings"
+)
+
 func (node AlterFunctionStmt) Deparse(ctx Context) (*string, error) {
-	panic("Not Implemented")
+	out := []string{"ALTER FUNCTION"}
+
+	if node.Func == nil {
+		return nil, errors.New("function of alter function statement cannot be null")
+	}
+
+	if str, err := deparseNode(*node.Func, Context_None); err != nil {
+		return nil, err
+	} else {
+		out = append(out, *str)
+	}
+
+	for _, item := range node.Actions.Items {
+		action, ok := item.(DefElem)
+		if !ok {
+			return nil, errors.Errorf("invalid function action: %T", item)
+		}
+		if str, err := deparseFunctionOption(action); err != nil {
+			return nil, err
+		} else {
+			out = append(out, *str)
+		}
+	}
+
+	result := strings.Join(out, " ")
+	return &result, nil
 }
@@ -2,6 +2,30 @@
 
 package pg_query
 
+import (
+	"strings"
+)
+
 func (node AlterObjectDependsStmt) Deparse(ctx Context) (*string, error) {
-	panic("Not Implemented")
+	out := []string{"ALTER"}
+	if str, err := deparseObjectType(node.ObjectType); err != nil {
+		return nil, err
+	} else {
+		out = append(out, *str)
+	}
+
+	if str, err := deparseObjectName(node.ObjectType, node.Relation, node.Object); err != nil {
+		return nil, err
+	} else {
+		out = append(out, *str)
+	}
+
+	if str, err := deparseNode(node.Extname, Context_None); err != nil {
+		return nil, err
+	} else {
+		out = append(out, "DEPENDS ON EXTENSION", *str)
+	}
+
+	result := strings.Join(out, " ")
+	return &result, nil
 }
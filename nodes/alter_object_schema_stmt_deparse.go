@@ -2,6 +2,35 @@
 
 package pg_query
 
+import (
+	"strings"
+
+	"github.com/juju/errors"
+)
+
 func (node AlterObjectSchemaStmt) Deparse(ctx Context) (*string, error) {
-	panic("Not Implemented")
+	out := []string{"ALTER"}
+	if str, err := deparseObjectType(node.ObjectType); err != nil {
+		return nil, err
+	} else {
+		out = append(out, *str)
+	}
+
+	if node.MissingOk {
+		out = append(out, "IF EXISTS")
+	}
+
+	if str, err := deparseObjectName(node.ObjectType, node.Relation, node.Object); err != nil {
+		return nil, err
+	} else {
+		out = append(out, *str)
+	}
+
+	if node.Newschema == nil {
+		return nil, errors.New("new schema cannot be null in alter object schema")
+	}
+	out = append(out, "SET SCHEMA", quoteIdentifier(*node.Newschema))
+
+	result := strings.Join(out, " ")
+	return &result, nil
 }
@@ -2,6 +2,38 @@
 
 package pg_query
 
+import (
+	"strings"
+
+	"github.com/juju/errors"
+)
+
 func (node AlterOpFamilyStmt) Deparse(ctx Context) (*string, error) {
-	panic("Not Implemented")
+	if node.Amname == nil {
+		return nil, errors.New("access method cannot be null in alter operator family")
+	}
+
+	out := []string{"ALTER OPERATOR FAMILY"}
+	if names, err := node.Opfamilyname.DeparseList(Context_None); err != nil {
+		return nil, err
+	} else {
+		out = append(out, strings.Join(names, "."))
+	}
+
+	out = append(out, "USING", quoteIdentifier(*node.Amname))
+
+	if node.IsDrop {
+		out = append(out, "DROP")
+	} else {
+		out = append(out, "ADD")
+	}
+
+	if items, err := node.Items.DeparseList(Context_None); err != nil {
+		return nil, err
+	} else {
+		out = append(out, strings.Join(items, ", "))
+	}
+
+	result := strings.Join(out, " ")
+	return &result, nil
 }
@@ -2,6 +2,44 @@
 
 package pg_query
 
+import (
+	"fmt"
+	"strings"
+
+	"github.com/juju/errors"
+)
+
 func (node AlterOperatorStmt) Deparse(ctx Context) (*string, error) {
-	panic("Not Implemented")
+	if node.Opername == nil {
+		return nil, errors.New("operator cannot be null in alter operator")
+	}
+
+	out := []string{"ALTER OPERATOR"}
+	if str, err := deparseNode(*node.Opername, Context_Operator); err != nil {
+		return nil, err
+	} else {
+		out = append(out, *str)
+	}
+
+	// Unlike in CREATE OPERATOR an option without a value removes it, which
+	// has to be written as NONE.
+	options := make([]string, len(node.Options.Items))
+	for i, item := range node.Options.Items {
+		option, ok := item.(DefElem)
+		if !ok || option.Defname == nil {
+			return nil, errors.Errorf("cannot deparse operator option of type %T", item)
+		}
+
+		if option.Arg == nil {
+			options[i] = fmt.Sprintf("%s = NONE", quoteLabel(*option.Defname))
+		} else if str, err := deparseDefinitionElem(option); err != nil {
+			return nil, err
+		} else {
+			options[i] = *str
+		}
+	}
+	out = append(out, fmt.Sprintf("SET (%s)", strings.Join(options, ", ")))
+
+	result := strings.Join(out, " ")
+	return &result, nil
 }
@@ -2,6 +2,36 @@
 
 package pg_query
 
+import (
+	"strings"
+
+	"github.com/juju/errors"
+)
+
 func (node AlterOwnerStmt) Deparse(ctx Context) (*string, error) {
-	panic("Not Implemented")
+	out := []string{"ALTER"}
+	if str, err := deparseObjectType(node.ObjectType); err != nil {
+		return nil, err
+	} else {
+		out = append(out, *str)
+	}
+
+	if str, err := deparseObjectName(node.ObjectType, node.Relation, node.Object); err != nil {
+		return nil, err
+	} else {
+		out = append(out, *str)
+	}
+
+	if node.Newowner == nil {
+		return nil, errors.New("new owner cannot be null in alter owner")
+	}
+
+	if str, err := deparseNode(*node.Newowner, Context_None); err != nil {
+		return nil, err
+	} else {
+		out = append(out, "OWNER TO", *str)
+	}
+
+	result := strings.Join(out, " ")
+	return &result, nil
 }
@@ -0,0 +1,119 @@
+/*
+ * Copyright (c) 2019 Ready Stock
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+package pg_query
+
+import (
+	"testing"
+)
+
+func Test_AlterOwner_Database(t *testing.T) {
+	DoTest(t, DeparseTest{
+		Query:    `ALTER DATABASE regression_db OWNER TO regress_user;`,
+		Expected: `ALTER DATABASE "regression_db" OWNER TO regress_user`,
+	})
+}
+
+func Test_AlterOwner_Function(t *testing.T) {
+	DoTest(t, DeparseTest{
+		Query:    `ALTER FUNCTION f(int) OWNER TO CURRENT_USER;`,
+		Expected: `ALTER FUNCTION f(int) OWNER TO CURRENT_USER`,
+	})
+}
+
+func Test_AlterOwner_Aggregate(t *testing.T) {
+	DoTest(t, DeparseTest{
+		Query:    `ALTER AGGREGATE cnt(*) OWNER TO regress_user;`,
+		Expected: `ALTER AGGREGATE cnt(*) OWNER TO regress_user`,
+	})
+}
+
+func Test_AlterOwner_OperatorClass(t *testing.T) {
+	DoTest(t, DeparseTest{
+		Query:    `ALTER OPERATOR CLASS public.c USING btree OWNER TO regress_user;`,
+		Expected: `ALTER OPERATOR CLASS "public"."c" USING "btree" OWNER TO regress_user`,
+	})
+}
+
+func Test_AlterOwner_Type(t *testing.T) {
+	DoTest(t, DeparseTest{
+		Query:    `ALTER TYPE public.pair OWNER TO regress_user;`,
+		Expected: `ALTER TYPE "public"."pair" OWNER TO regress_user`,
+	})
+}
+
+func Test_AlterOwner_LargeObject(t *testing.T) {
+	DoTest(t, DeparseTest{
+		Query:    `ALTER LARGE OBJECT 42 OWNER TO regress_user;`,
+		Expected: `ALTER LARGE OBJECT 42 OWNER TO regress_user`,
+	})
+}
+
+func Test_AlterOwner_Publication(t *testing.T) {
+	DoTest(t, DeparseTest{
+		Query:    `ALTER PUBLICATION pub OWNER TO regress_user;`,
+		Expected: `ALTER PUBLICATION "pub" OWNER TO regress_user`,
+	})
+}
+
+func Test_AlterObjectSchema_Table(t *testing.T) {
+	DoTest(t, DeparseTest{
+		Query:    `ALTER TABLE IF EXISTS t SET SCHEMA app;`,
+		Expected: `ALTER TABLE IF EXISTS "t" SET SCHEMA app`,
+	})
+}
+
+func Test_AlterObjectSchema_Function(t *testing.T) {
+	DoTest(t, DeparseTest{
+		Query:    `ALTER FUNCTION f(int) SET SCHEMA app;`,
+		Expected: `ALTER FUNCTION f(int) SET SCHEMA app`,
+	})
+}
+
+func Test_AlterObjectSchema_Extension(t *testing.T) {
+	DoTest(t, DeparseTest{
+		Query:    `ALTER EXTENSION hstore SET SCHEMA app;`,
+		Expected: `ALTER EXTENSION "hstore" SET SCHEMA app`,
+	})
+}
+
+func Test_AlterObjectSchema_Operator(t *testing.T) {
+	DoTest(t, DeparseTest{
+		Query:    `ALTER OPERATOR @+@(int, int) SET SCHEMA app;`,
+		Expected: `ALTER OPERATOR @+@(int, int) SET SCHEMA app`,
+	})
+}
+
+func Test_AlterObjectDepends_Function(t *testing.T) {
+	DoTest(t, DeparseTest{
+		Query:    `ALTER FUNCTION f(int) DEPENDS ON EXTENSION hstore;`,
+		Expected: `ALTER FUNCTION f(int) DEPENDS ON EXTENSION "hstore"`,
+	})
+}
+
+func Test_AlterObjectDepends_Trigger(t *testing.T) {
+	DoTest(t, DeparseTest{
+		Query:    `ALTER TRIGGER tr ON t DEPENDS ON EXTENSION hstore;`,
+		Expected: `ALTER TRIGGER "tr" ON "t" DEPENDS ON EXTENSION "hstore"`,
+	})
+}
+
+func Test_AlterObjectDepends_Index(t *testing.T) {
+	DoTest(t, DeparseTest{
+		Query:    `ALTER INDEX i DEPENDS ON EXTENSION hstore;`,
+		Expected: `ALTER INDEX "i" DEPENDS ON EXTENSION "hstore"`,
+	})
+}
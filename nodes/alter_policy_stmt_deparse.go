@@ -2,6 +2,31 @@
 
 package pg_query
 
+import (
+	"strings"
+
+	"github.com/juju/errors"
+)
+
 func (node AlterPolicyStmt) Deparse(ctx Context) (*string, error) {
-	panic("Not Implemented")
+	if node.PolicyName == nil || node.Table == nil {
+		return nil, errors.New("policy must have a name and a table")
+	}
+
+	out := []string{"ALTER POLICY", quoteIdentifier(*node.PolicyName)}
+
+	if str, err := node.Table.Deparse(Context_None); err != nil {
+		return nil, err
+	} else {
+		out = append(out, "ON", *str)
+	}
+
+	if str, err := deparsePolicyClauses(node.Roles, node.Qual, node.WithCheck); err != nil {
+		return nil, err
+	} else if str != nil {
+		out = append(out, *str)
+	}
+
+	result := strings.Join(out, " ")
+	return &result, nil
 }
@@ -2,6 +2,38 @@
 
 package pg_query
 
+import (
+	"strings"
+
+	"github.com/juju/errors"
+)
+
 func (node AlterPublicationStmt) Deparse(ctx Context) (*string, error) {
-	panic("Not Implemented")
+	if node.Pubname == nil {
+		return nil, errors.New("publication must have a name")
+	}
+
+	out := []string{"ALTER PUBLICATION", quoteIdentifier(*node.Pubname)}
+
+	// Either the options or the tables of the publication are changed.
+	if node.Tables.Items != nil && len(node.Tables.Items) > 0 {
+		if action, ok := defElemActions[node.TableAction]; !ok {
+			return nil, errors.Errorf("cannot deparse alter publication table action (%d)", node.TableAction)
+		} else {
+			out = append(out, action, "TABLE")
+		}
+
+		if tables, err := node.Tables.DeparseList(Context_None); err != nil {
+			return nil, err
+		} else {
+			out = append(out, strings.Join(tables, ", "))
+		}
+	} else if str, err := deparseDefinition(node.Options); err != nil {
+		return nil, err
+	} else {
+		out = append(out, "SET", *str)
+	}
+
+	result := strings.Join(out, " ")
+	return &result, nil
 }
@@ -2,6 +2,39 @@
 
 package pg_query
 
+import (
+	"github.com/juju/errors"
+	"strings"
+)
+
 func (node AlterRoleSetStmt) Deparse(ctx Context) (*string, error) {
-	panic("Not Implemented")
+	out := []string{"ALTER ROLE"}
+
+	// A missing role means the setting applies to all roles.
+	if node.Role != nil {
+		if str, err := deparseNode(*node.Role, Context_None); err != nil {
+			return nil, err
+		} else {
+			out = append(out, *str)
+		}
+	} else {
+		out = append(out, "ALL")
+	}
+
+	if node.Database != nil {
+		out = append(out, "IN DATABASE", quoteIdentifier(*node.Database))
+	}
+
+	if node.Setstmt == nil {
+		return nil, errors.New("alter role set statement must have a set statement")
+	}
+
+	if str, err := deparseNode(*node.Setstmt, Context_None); err != nil {
+		return nil, err
+	} else {
+		out = append(out, *str)
+	}
+
+	result := strings.Join(out, " ")
+	return &result, nil
 }
@@ -2,6 +2,66 @@
 
 package pg_query
 
+import (
+	"github.com/juju/errors"
+	"strings"
+)
+
 func (node AlterRoleStmt) Deparse(ctx Context) (*string, error) {
-	panic("Not Implemented")
+	if node.Role == nil {
+		return nil, errors.New("role of alter role statement cannot be null")
+	}
+
+	role, err := deparseNode(*node.Role, Context_None)
+	if err != nil {
+		return nil, err
+	}
+
+	// ALTER GROUP ... ADD/DROP USER is the only form that changes the members of
+	// a role, all other options are handled by ALTER ROLE.
+	if option, ok := node.groupMembersOption(); ok {
+		members, ok := option.Arg.(List)
+		if !ok {
+			return nil, errors.New("members of alter group must be a list of roles")
+		}
+
+		out := []string{"ALTER GROUP", *role}
+		if node.Action < 0 {
+			out = append(out, "DROP USER")
+		} else {
+			out = append(out, "ADD USER")
+		}
+
+		if roles, err := members.DeparseList(Context_None); err != nil {
+			return nil, err
+		} else {
+			out = append(out, strings.Join(roles, ", "))
+		}
+
+		result := strings.Join(out, " ")
+		return &result, nil
+	}
+
+	out := []string{"ALTER ROLE", *role}
+	if node.Options.Items != nil && len(node.Options.Items) > 0 {
+		if options, err := deparseRoleOptions(node.Options); err != nil {
+			return nil, err
+		} else {
+			out = append(out, "WITH", *options)
+		}
+	}
+
+	result := strings.Join(out, " ")
+	return &result, nil
+}
+
+// groupMembersOption returns the option that changes the members of the role
+// when that is the only option of the statement.
+func (node AlterRoleStmt) groupMembersOption() (DefElem, bool) {
+	if len(node.Options.Items) != 1 {
+		return DefElem{}, false
+	}
+
+	option, ok := node.Options.Items[0].(DefElem)
+	return option, ok && option.Defname != nil && *option.Defname == "rolemembers"
 }
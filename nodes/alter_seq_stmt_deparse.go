@@ -2,6 +2,34 @@
 
 package pg_query
 
+import (
+	"strings"
+
+	"github.com/juju/errors"
+)
+
 func (node AlterSeqStmt) Deparse(ctx Context) (*string, error) {
-	panic("Not Implemented")
+	if node.Sequence == nil {
+		return nil, errors.New("sequence cannot be null in alter sequence")
+	}
+
+	out := []string{"ALTER SEQUENCE"}
+	if node.MissingOk {
+		out = append(out, "IF EXISTS")
+	}
+
+	if str, err := deparseNode(*node.Sequence, Context_None); err != nil {
+		return nil, err
+	} else {
+		out = append(out, *str)
+	}
+
+	if options, err := deparseSeqOptions(node.Options); err != nil {
+		return nil, err
+	} else {
+		out = append(out, options...)
+	}
+
+	result := strings.Join(out, " ")
+	return &result, nil
 }
@@ -2,6 +2,73 @@
 
 package pg_query
 
+import (
+	"strings"
+
+	"github.com/juju/errors"
+)
+
 func (node AlterSubscriptionStmt) Deparse(ctx Context) (*string, error) {
-	panic("Not Implemented")
+	if node.Subname == nil {
+		return nil, errors.New("subscription must have a name")
+	}
+
+	out := []string{"ALTER SUBSCRIPTION", quoteIdentifier(*node.Subname)}
+
+	switch node.Kind {
+	case ALTER_SUBSCRIPTION_OPTIONS:
+		if str, err := deparseDefinition(node.Options); err != nil {
+			return nil, err
+		} else {
+			out = append(out, "SET", *str)
+		}
+		result := strings.Join(out, " ")
+		return &result, nil
+	case ALTER_SUBSCRIPTION_CONNECTION:
+		if node.Conninfo == nil {
+			return nil, errors.New("subscription connection cannot be null")
+		}
+		out = append(out, "CONNECTION", quoteLiteral(*node.Conninfo))
+	case ALTER_SUBSCRIPTION_PUBLICATION:
+		if publications, err := node.Publication.DeparseList(Context_None); err != nil {
+			return nil, err
+		} else {
+			out = append(out, "SET PUBLICATION", strings.Join(publications, ", "))
+		}
+	case ALTER_SUBSCRIPTION_REFRESH:
+		out = append(out, "REFRESH PUBLICATION")
+	case ALTER_SUBSCRIPTION_ENABLED:
+		// The parser stores whether the subscription is enabled as an option.
+		if len(node.Options.Items) != 1 {
+			return nil, errors.New("enabling a subscription must have exactly one option")
+		}
+
+		option, ok := node.Options.Items[0].(DefElem)
+		if !ok {
+			return nil, errors.New("enabling a subscription must have a definition")
+		}
+
+		if enabled, ok := option.Arg.(Integer); !ok {
+			return nil, errors.New("enabling a subscription must have an integer argument")
+		} else if enabled.Ival != 0 {
+			out = append(out, "ENABLE")
+		} else {
+			out = append(out, "DISABLE")
+		}
+		result := strings.Join(out, " ")
+		return &result, nil
+	default:
+		return nil, errors.Errorf("cannot deparse alter subscription type (%d)", node.Kind)
+	}
+
+	if node.Options.Items != nil && len(node.Options.Items) > 0 {
+		if str, err := deparseDefinition(node.Options); err != nil {
+			return nil, err
+		} else {
+			out = append(out, "WITH", *str)
+		}
+	}
+
+	result := strings.Join(out, " ")
+	return &result, nil
 }
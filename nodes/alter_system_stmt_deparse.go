@@ -2,6 +2,24 @@
 
 package pg_query
 
+import (
+	"strings"
+
+	"github.com/juju/errors"
+)
+
 func (node AlterSystemStmt) Deparse(ctx Context) (*string, error) {
-	panic("Not Implemented")
+	out := []string{"ALTER SYSTEM"}
+	if node.Setstmt == nil {
+		return nil, errors.New("alter system statement must have a set statement")
+	}
+
+	if str, err := deparseNode(*node.Setstmt, Context_None); err != nil {
+		return nil, err
+	} else {
+		out = append(out, *str)
+	}
+
+	result := strings.Join(out, " ")
+	return &result, nil
 }
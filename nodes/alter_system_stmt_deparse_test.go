@@ -0,0 +1,49 @@
+/*
+ * Copyright (c) 2019 Ready Stock
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+package pg_query
+
+import (
+	"testing"
+)
+
+func Test_AlterSystem(t *testing.T) {
+	DoTest(t, DeparseTest{
+		Query:    `ALTER SYSTEM SET work_mem TO '64MB';`,
+		Expected: `ALTER SYSTEM SET work_mem TO '64MB'`,
+	})
+}
+
+func Test_AlterSystem_Reset(t *testing.T) {
+	DoTest(t, DeparseTest{
+		Query:    `ALTER SYSTEM RESET ALL;`,
+		Expected: `ALTER SYSTEM RESET ALL`,
+	})
+}
+
+func Test_ConstraintsSet(t *testing.T) {
+	DoTest(t, DeparseTest{
+		Query:    `SET CONSTRAINTS ALL DEFERRED;`,
+		Expected: `SET CONSTRAINTS ALL DEFERRED`,
+	})
+}
+
+func Test_ConstraintsSet_Names(t *testing.T) {
+	DoTest(t, DeparseTest{
+		Query:    `SET CONSTRAINTS public.fk1, fk2 IMMEDIATE;`,
+		Expected: `SET CONSTRAINTS "public"."fk1", "fk2" IMMEDIATE`,
+	})
+}
@@ -2,6 +2,354 @@
 
 package pg_query
 
+import (
+	"fmt"
+	"github.com/juju/errors"
+	"strings"
+)
+
+var (
+	alterTableSimpleCmds = map[AlterTableType]string{
+		AT_DropCluster:        "SET WITHOUT CLUSTER",
+		AT_SetLogged:          "SET LOGGED",
+		AT_SetUnLogged:        "SET UNLOGGED",
+		AT_AddOids:            "SET WITH OIDS",
+		AT_DropOids:           "SET WITHOUT OIDS",
+		AT_EnableTrigAll:      "ENABLE TRIGGER ALL",
+		AT_DisableTrigAll:     "DISABLE TRIGGER ALL",
+		AT_EnableTrigUser:     "ENABLE TRIGGER USER",
+		AT_DisableTrigUser:    "DISABLE TRIGGER USER",
+		AT_DropOf:             "NOT OF",
+		AT_EnableRowSecurity:  "ENABLE ROW LEVEL SECURITY",
+		AT_DisableRowSecurity: "DISABLE ROW LEVEL SECURITY",
+		AT_ForceRowSecurity:   "FORCE ROW LEVEL SECURITY",
+		AT_NoForceRowSecurity: "NO FORCE ROW LEVEL SECURITY",
+	}
+
+	alterTableNamedCmds = map[AlterTableType]string{
+		AT_ValidateConstraint: "VALIDATE CONSTRAINT",
+		AT_ClusterOn:          "CLUSTER ON",
+		AT_SetTableSpace:      "SET TABLESPACE",
+		AT_EnableTrig:         "ENABLE TRIGGER",
+		AT_EnableAlwaysTrig:   "ENABLE ALWAYS TRIGGER",
+		AT_EnableReplicaTrig:  "ENABLE REPLICA TRIGGER",
+		AT_DisableTrig:        "DISABLE TRIGGER",
+		AT_EnableRule:         "ENABLE RULE",
+		AT_EnableAlwaysRule:   "ENABLE ALWAYS RULE",
+		AT_EnableReplicaRule:  "ENABLE REPLICA RULE",
+		AT_DisableRule:        "DISABLE RULE",
+	}
+)
+
 func (node AlterTableCmd) Deparse(ctx Context) (*string, error) {
-	panic("Not Implemented")
+	return node.deparseForRelkind(OBJECT_TABLE)
+}
+
+// deparseForRelkind deparses the command as part of an ALTER statement for the
+// provided kind of relation. Composite types refer to their columns as
+// attributes, so the keywords used differ slightly.
+func (node AlterTableCmd) deparseForRelkind(relkind ObjectType) (*string, error) {
+	if cmd, ok := alterTableSimpleCmds[node.Subtype]; ok {
+		return &cmd, nil
+	}
+
+	// Most commands refer to a column, constraint or other object by name.
+	name := ""
+	if node.Name != nil {
+		name = quoteIdentifier(*node.Name)
+	} else {
+		switch node.Subtype {
+		case AT_AddColumn, AT_AddConstraint, AT_AlterConstraint, AT_ChangeOwner, AT_SetRelOptions,
+			AT_ResetRelOptions, AT_ReplaceRelOptions, AT_AddInherit, AT_DropInherit, AT_AddOf,
+			AT_ReplicaIdentity, AT_GenericOptions, AT_AttachPartition, AT_DetachPartition:
+		default:
+			return nil, errors.Errorf("name cannot be null in alter table command type (%d)", node.Subtype)
+		}
+	}
+
+	if cmd, ok := alterTableNamedCmds[node.Subtype]; ok {
+		result := fmt.Sprintf("%s %s", cmd, name)
+		return &result, nil
+	}
+
+	column := "COLUMN"
+	if relkind == OBJECT_TYPE {
+		column = "ATTRIBUTE"
+	}
+
+	out := make([]string, 0)
+	switch node.Subtype {
+	case AT_AddColumn:
+		out = append(out, "ADD", column)
+		if node.MissingOk {
+			out = append(out, "IF NOT EXISTS")
+		}
+		if str, err := deparseNode(node.Def, Context_None); err != nil {
+			return nil, err
+		} else {
+			out = append(out, *str)
+		}
+	case AT_DropColumn:
+		out = append(out, "DROP", column)
+		if node.MissingOk {
+			out = append(out, "IF EXISTS")
+		}
+		out = append(out, name)
+	case AT_ColumnDefault:
+		out = append(out, "ALTER", column, name)
+		if node.Def != nil {
+			out = append(out, "SET DEFAULT")
+			if str, err := deparseNode(node.Def, Context_None); err != nil {
+				return nil, err
+			} else {
+				out = append(out, *str)
+			}
+		} else {
+			out = append(out, "DROP DEFAULT")
+		}
+	case AT_DropNotNull:
+		out = append(out, "ALTER", column, name, "DROP NOT NULL")
+	case AT_SetNotNull:
+		out = append(out, "ALTER", column, name, "SET NOT NULL")
+	case AT_SetStatistics:
+		out = append(out, "ALTER", column, name, "SET STATISTICS")
+		if str, err := deparseNode(node.Def, Context_None); err != nil {
+			return nil, err
+		} else {
+			out = append(out, *str)
+		}
+	case AT_SetOptions, AT_ResetOptions:
+		out = append(out, "ALTER", column, name)
+		if node.Subtype == AT_SetOptions {
+			out = append(out, "SET")
+		} else {
+			out = append(out, "RESET")
+		}
+		options, ok := node.Def.(List)
+		if !ok {
+			return nil, errors.New("options of alter table command must be a list")
+		}
+
+		if str, err := deparseRelOptions(options); err != nil {
+			return nil, err
+		} else {
+			out = append(out, *str)
+		}
+	case AT_SetStorage:
+		storage, ok := node.Def.(String)
+		if !ok {
+			return nil, errors.New("storage of alter column must be a name")
+		}
+		out = append(out, "ALTER", column, name, "SET STORAGE", quoteIdentifier(storage.Str))
+	case AT_AlterColumnType:
+		def, ok := node.Def.(ColumnDef)
+		if !ok {
+			return nil, errors.New("alter column type must have a column definition")
+		}
+
+		out = append(out, "ALTER", column, name, "TYPE")
+		if str, err := deparseNode(*def.TypeName, Context_None); err != nil {
+			return nil, err
+		} else {
+			out = append(out, *str)
+		}
+
+		if def.CollClause != nil {
+			if str, err := deparseNode(*def.CollClause, Context_None); err != nil {
+				return nil, err
+			} else {
+				out = append(out, *str)
+			}
+		}
+
+		if def.RawDefault != nil {
+			out = append(out, "USING")
+			if str, err := deparseNode(def.RawDefault, Context_None); err != nil {
+				return nil, err
+			} else {
+				out = append(out, *str)
+			}
+		}
+	case AT_AlterColumnGenericOptions:
+		out = append(out, "ALTER", column, name, "OPTIONS")
+		options, ok := node.Def.(List)
+		if !ok {
+			return nil, errors.New("options of alter table command must be a list")
+		}
+
+		if items, err := options.DeparseList(Context_None); err != nil {
+			return nil, err
+		} else {
+			out = append(out, fmt.Sprintf("(%s)", strings.Join(items, ", ")))
+		}
+	case AT_AddConstraint:
+		out = append(out, "ADD")
+		if str, err := deparseNode(node.Def, Context_None); err != nil {
+			return nil, err
+		} else {
+			out = append(out, *str)
+		}
+	case AT_AlterConstraint:
+		def, ok := node.Def.(Constraint)
+		if !ok {
+			return nil, errors.New("alter constraint must have a constraint definition")
+		}
+
+		if def.Conname == nil {
+			return nil, errors.New("constraint name cannot be null in alter constraint")
+		}
+
+		out = append(out, "ALTER CONSTRAINT", quoteIdentifier(*def.Conname))
+		if def.Deferrable {
+			out = append(out, "DEFERRABLE")
+		} else {
+			out = append(out, "NOT DEFERRABLE")
+		}
+		if def.Initdeferred {
+			out = append(out, "INITIALLY DEFERRED")
+		} else {
+			out = append(out, "INITIALLY IMMEDIATE")
+		}
+	case AT_DropConstraint:
+		out = append(out, "DROP CONSTRAINT")
+		if node.MissingOk {
+			out = append(out, "IF EXISTS")
+		}
+		out = append(out, name)
+	case AT_ChangeOwner:
+		if node.Newowner == nil {
+			return nil, errors.New("new owner cannot be null in alter table")
+		}
+
+		out = append(out, "OWNER TO")
+		if str, err := deparseNode(*node.Newowner, Context_None); err != nil {
+			return nil, err
+		} else {
+			out = append(out, *str)
+		}
+	case AT_SetRelOptions, AT_ResetRelOptions, AT_ReplaceRelOptions:
+		if node.Subtype == AT_ResetRelOptions {
+			out = append(out, "RESET")
+		} else {
+			out = append(out, "SET")
+		}
+		options, ok := node.Def.(List)
+		if !ok {
+			return nil, errors.New("options of alter table command must be a list")
+		}
+
+		if str, err := deparseRelOptions(options); err != nil {
+			return nil, err
+		} else {
+			out = append(out, *str)
+		}
+	case AT_AddInherit, AT_DropInherit:
+		if node.Subtype == AT_DropInherit {
+			out = append(out, "NO")
+		}
+		out = append(out, "INHERIT")
+		if str, err := deparseNode(node.Def, Context_None); err != nil {
+			return nil, err
+		} else {
+			out = append(out, *str)
+		}
+	case AT_AddOf:
+		out = append(out, "OF")
+		if str, err := deparseNode(node.Def, Context_None); err != nil {
+			return nil, err
+		} else {
+			out = append(out, *str)
+		}
+	case AT_ReplicaIdentity:
+		out = append(out, "REPLICA IDENTITY")
+		if str, err := deparseNode(node.Def, Context_None); err != nil {
+			return nil, err
+		} else {
+			out = append(out, *str)
+		}
+	case AT_GenericOptions:
+		out = append(out, "OPTIONS")
+		options, ok := node.Def.(List)
+		if !ok {
+			return nil, errors.New("options of alter table command must be a list")
+		}
+
+		if items, err := options.DeparseList(Context_None); err != nil {
+			return nil, err
+		} else {
+			out = append(out, fmt.Sprintf("(%s)", strings.Join(items, ", ")))
+		}
+	case AT_AttachPartition, AT_DetachPartition:
+		if node.Subtype == AT_AttachPartition {
+			out = append(out, "ATTACH PARTITION")
+		} else {
+			out = append(out, "DETACH PARTITION")
+		}
+		if str, err := deparseNode(node.Def, Context_None); err != nil {
+			return nil, err
+		} else {
+			out = append(out, *str)
+		}
+	case AT_AddIdentity:
+		out = append(out, "ALTER", column, name, "ADD")
+		if str, err := deparseNode(node.Def, Context_None); err != nil {
+			return nil, err
+		} else {
+			out = append(out, *str)
+		}
+	case AT_SetIdentity:
+		out = append(out, "ALTER", column, name)
+		options, ok := node.Def.(List)
+		if !ok {
+			return nil, errors.New("options of alter identity must be a list")
+		}
+
+		for _, item := range options.Items {
+			option, ok := item.(DefElem)
+			if !ok {
+				return nil, errors.Errorf("cannot deparse identity option of type %T", item)
+			}
+
+			switch *option.Defname {
+			case "restart":
+				if str, err := option.deparseSeqOption(); err != nil {
+					return nil, err
+				} else {
+					out = append(out, *str)
+				}
+			case "generated":
+				generated, ok := option.Arg.(Integer)
+				if !ok {
+					return nil, errors.New("generated option of alter identity must be an integer")
+				}
+
+				out = append(out, "SET GENERATED")
+				if generated.Ival == 'a' {
+					out = append(out, "ALWAYS")
+				} else {
+					out = append(out, "BY DEFAULT")
+				}
+			default:
+				if str, err := option.deparseSeqOption(); err != nil {
+					return nil, err
+				} else {
+					out = append(out, "SET", *str)
+				}
+			}
+		}
+	case AT_DropIdentity:
+		out = append(out, "ALTER", column, name, "DROP IDENTITY")
+		if node.MissingOk {
+			out = append(out, "IF EXISTS")
+		}
+	default:
+		return nil, errors.Errorf("cannot deparse alter table command type (%d)", node.Subtype)
+	}
+
+	if node.Behavior == DROP_CASCADE {
+		out = append(out, "CASCADE")
+	}
+
+	result := strings.Join(out, " ")
+	return &result, nil
 }
@@ -2,6 +2,39 @@
 
 package pg_query
 
+import (
+	"strings"
+
+	"github.com/juju/errors"
+)
+
 func (node AlterTableMoveAllStmt) Deparse(ctx Context) (*string, error) {
-	panic("Not Implemented")
+	out := []string{"ALTER"}
+	switch node.Objtype {
+	case OBJECT_TABLE, OBJECT_INDEX, OBJECT_MATVIEW:
+		out = append(out, objectTypeNames[node.Objtype])
+	default:
+		return nil, errors.Errorf("cannot deparse move all for object type [%s]", node.Objtype.String())
+	}
+
+	if node.OrigTablespacename == nil || node.NewTablespacename == nil {
+		return nil, errors.New("tablespace names cannot be null in move all")
+	}
+	out = append(out, "ALL IN TABLESPACE", quoteIdentifier(*node.OrigTablespacename))
+
+	if node.Roles.Items != nil && len(node.Roles.Items) > 0 {
+		if roles, err := node.Roles.DeparseList(Context_None); err != nil {
+			return nil, err
+		} else {
+			out = append(out, "OWNED BY", strings.Join(roles, ", "))
+		}
+	}
+
+	out = append(out, "SET TABLESPACE", quoteIdentifier(*node.NewTablespacename))
+	if node.Nowait {
+		out = append(out, "NOWAIT")
+	}
+
+	result := strings.Join(out, " ")
+	return &result, nil
 }
@@ -2,6 +2,31 @@
 
 package pg_query
 
+import (
+	"strings"
+
+	"github.com/juju/errors"
+)
+
 func (node AlterTableSpaceOptionsStmt) Deparse(ctx Context) (*string, error) {
-	panic("Not Implemented")
+	out := []string{"ALTER TABLESPACE"}
+	if node.Tablespacename == nil {
+		return nil, errors.New("tablespace name cannot be null in alter tablespace")
+	}
+	out = append(out, quoteIdentifier(*node.Tablespacename))
+
+	if node.IsReset {
+		out = append(out, "RESET")
+	} else {
+		out = append(out, "SET")
+	}
+
+	if str, err := deparseRelOptions(node.Options); err != nil {
+		return nil, err
+	} else {
+		out = append(out, *str)
+	}
+
+	result := strings.Join(out, " ")
+	return &result, nil
 }
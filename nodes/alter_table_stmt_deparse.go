@@ -2,6 +2,72 @@
 
 package pg_query
 
+import (
+	"github.com/juju/errors"
+	"strings"
+)
+
+var (
+	alterTableRelkinds = map[ObjectType]string{
+		OBJECT_TABLE:         "TABLE",
+		OBJECT_FOREIGN_TABLE: "FOREIGN TABLE",
+		OBJECT_INDEX:         "INDEX",
+		OBJECT_SEQUENCE:      "SEQUENCE",
+		OBJECT_VIEW:          "VIEW",
+		OBJECT_MATVIEW:       "MATERIALIZED VIEW",
+		OBJECT_TYPE:          "TYPE",
+	}
+)
+
 func (node AlterTableStmt) Deparse(ctx Context) (*string, error) {
-	panic("Not Implemented")
+	out := []string{"ALTER"}
+
+	if relkind, ok := alterTableRelkinds[node.Relkind]; !ok {
+		return nil, errors.Errorf("cannot deparse alter table for relkind [%s]", node.Relkind.String())
+	} else {
+		out = append(out, relkind)
+	}
+
+	if node.MissingOk {
+		out = append(out, "IF EXISTS")
+	}
+
+	if node.Relation == nil {
+		return nil, errors.New("relation of alter table statement cannot be null")
+	}
+
+	// Composite types are not inheritable, so their name is never marked as
+	// inherited. Treat it as such so that ONLY is not prepended to it.
+	relation := *node.Relation
+	if node.Relkind == OBJECT_TYPE {
+		relation.Inh = true
+	}
+
+	if str, err := deparseNode(relation, Context_None); err != nil {
+		return nil, err
+	} else {
+		out = append(out, *str)
+	}
+
+	if node.Cmds.Items == nil || len(node.Cmds.Items) == 0 {
+		return nil, errors.New("alter table statement must have at least one command")
+	}
+
+	cmds := make([]string, len(node.Cmds.Items))
+	for i, item := range node.Cmds.Items {
+		cmd, ok := item.(AlterTableCmd)
+		if !ok {
+			return nil, errors.Errorf("cannot deparse alter table command of type %T", item)
+		}
+
+		if str, err := cmd.deparseForRelkind(node.Relkind); err != nil {
+			return nil, err
+		} else {
+			cmds[i] = *str
+		}
+	}
+	out = append(out, strings.Join(cmds, ", "))
+
+	result := strings.Join(out, " ")
+	return &result, nil
 }
@@ -0,0 +1,256 @@
+/*
+ * Copyright (c) 2019 Ready Stock
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+package pg_query
+
+import (
+	"testing"
+)
+
+func Test_AlterTableStmt_AddColumn(t *testing.T) {
+	DoTest(t, DeparseTest{
+		Query:    `ALTER TABLE tmp ADD COLUMN a int default 3;`,
+		Expected: `ALTER TABLE "tmp" ADD COLUMN a int DEFAULT 3`,
+	})
+	DoTest(t, DeparseTest{
+		Query:    `ALTER TABLE IF EXISTS tt8 ADD COLUMN f int;`,
+		Expected: `ALTER TABLE IF EXISTS "tt8" ADD COLUMN f int`,
+	})
+	DoTest(t, DeparseTest{
+		Query:    `ALTER TABLE ONLY list_parted2 ADD COLUMN IF NOT EXISTS c int NOT NULL;`,
+		Expected: `ALTER TABLE ONLY "list_parted2" ADD COLUMN IF NOT EXISTS c int NOT NULL`,
+	})
+}
+
+func Test_AlterTableStmt_DropColumn(t *testing.T) {
+	DoTest(t, DeparseTest{
+		Query:    `ALTER TABLE part_7_a_null DROP c, DROP d, DROP e;`,
+		Expected: `ALTER TABLE "part_7_a_null" DROP COLUMN c, DROP COLUMN d, DROP COLUMN e`,
+	})
+	DoTest(t, DeparseTest{
+		Query:    `ALTER TABLE partitioned DROP COLUMN IF EXISTS a CASCADE;`,
+		Expected: `ALTER TABLE "partitioned" DROP COLUMN IF EXISTS a CASCADE`,
+	})
+}
+
+func Test_AlterTableStmt_AlterColumn(t *testing.T) {
+	DoTest(t, DeparseTest{
+		Query:    `ALTER TABLE IF EXISTS tt8 ALTER COLUMN f SET DEFAULT 0;`,
+		Expected: `ALTER TABLE IF EXISTS "tt8" ALTER COLUMN f SET DEFAULT 0`,
+	})
+	DoTest(t, DeparseTest{
+		Query:    `ALTER TABLE tt8 ALTER COLUMN f DROP DEFAULT;`,
+		Expected: `ALTER TABLE "tt8" ALTER COLUMN f DROP DEFAULT`,
+	})
+	DoTest(t, DeparseTest{
+		Query:    `ALTER TABLE list_parted2 ALTER b SET NOT NULL, ADD CONSTRAINT check_a2 CHECK (a > 0);`,
+		Expected: `ALTER TABLE "list_parted2" ALTER COLUMN b SET NOT NULL, ADD CONSTRAINT check_a2 CHECK ("a" > 0)`,
+	})
+	DoTest(t, DeparseTest{
+		Query:    `ALTER TABLE ONLY parted_no_parts ALTER a DROP NOT NULL;`,
+		Expected: `ALTER TABLE ONLY "parted_no_parts" ALTER COLUMN a DROP NOT NULL`,
+	})
+	DoTest(t, DeparseTest{
+		Query:    `ALTER TABLE test_type_diff ALTER COLUMN f2 TYPE bigint USING f2::bigint;`,
+		Expected: `ALTER TABLE "test_type_diff" ALTER COLUMN f2 TYPE bigint USING "f2"::bigint`,
+	})
+	DoTest(t, DeparseTest{
+		Query:    `ALTER TABLE fail_part ALTER b TYPE varchar(2) COLLATE "POSIX";`,
+		Expected: `ALTER TABLE "fail_part" ALTER COLUMN b TYPE varchar(2) COLLATE "POSIX"`,
+	})
+	DoTest(t, DeparseTest{
+		Query:    `ALTER TABLE stats ALTER COLUMN a SET STATISTICS 1000, ALTER COLUMN b SET STORAGE external;`,
+		Expected: `ALTER TABLE "stats" ALTER COLUMN a SET STATISTICS 1000, ALTER COLUMN b SET STORAGE external`,
+	})
+	DoTest(t, DeparseTest{
+		Query:    `ALTER TABLE stats ALTER COLUMN a SET (n_distinct = -1), ALTER COLUMN b RESET (n_distinct);`,
+		Expected: `ALTER TABLE "stats" ALTER COLUMN a SET (n_distinct=-1), ALTER COLUMN b RESET (n_distinct)`,
+	})
+}
+
+func Test_AlterTableStmt_Constraints(t *testing.T) {
+	DoTest(t, DeparseTest{
+		Query:    `ALTER TABLE IF EXISTS tt8 ADD CONSTRAINT xxx PRIMARY KEY(f);`,
+		Expected: `ALTER TABLE IF EXISTS "tt8" ADD CONSTRAINT xxx PRIMARY KEY ("f")`,
+	})
+	DoTest(t, DeparseTest{
+		Query:    `ALTER TABLE part_5 ADD CONSTRAINT check_a CHECK (a IS NOT NULL AND a = 5);`,
+		Expected: `ALTER TABLE "part_5" ADD CONSTRAINT check_a CHECK ("a" IS NOT NULL AND "a" = 5)`,
+	})
+	DoTest(t, DeparseTest{
+		Query:    `ALTER TABLE constraint_rename_test ADD CONSTRAINT con2 CHECK (b > 0) NO INHERIT;`,
+		Expected: `ALTER TABLE "constraint_rename_test" ADD CONSTRAINT con2 CHECK ("b" > 0) NO INHERIT`,
+	})
+	DoTest(t, DeparseTest{
+		Query:    `ALTER TABLE FKTABLE ADD FOREIGN KEY(ftest1, ftest2) references pktable;`,
+		Expected: `ALTER TABLE "fktable" ADD FOREIGN KEY ("ftest1", "ftest2") REFERENCES "pktable"`,
+	})
+	DoTest(t, DeparseTest{
+		Query:    `ALTER TABLE FKTABLE ADD CONSTRAINT fkdd FOREIGN KEY(ftest1) REFERENCES pktable (ptest1) MATCH FULL ON DELETE CASCADE ON UPDATE SET NULL DEFERRABLE INITIALLY DEFERRED NOT VALID;`,
+		Expected: `ALTER TABLE "fktable" ADD CONSTRAINT fkdd FOREIGN KEY ("ftest1") REFERENCES "pktable" ("ptest1") MATCH FULL ON DELETE CASCADE ON UPDATE SET NULL DEFERRABLE INITIALLY DEFERRED NOT VALID`,
+	})
+	DoTest(t, DeparseTest{
+		Query:    `ALTER TABLE FKTABLE ALTER CONSTRAINT fkdd2 DEFERRABLE INITIALLY DEFERRED;`,
+		Expected: `ALTER TABLE "fktable" ALTER CONSTRAINT fkdd2 DEFERRABLE INITIALLY DEFERRED`,
+	})
+	DoTest(t, DeparseTest{
+		Query:    `ALTER TABLE FKTABLE ALTER CONSTRAINT fknd2 NOT DEFERRABLE;`,
+		Expected: `ALTER TABLE "fktable" ALTER CONSTRAINT fknd2 NOT DEFERRABLE INITIALLY IMMEDIATE`,
+	})
+	DoTest(t, DeparseTest{
+		Query:    `ALTER TABLE FKTABLE VALIDATE CONSTRAINT fkdd;`,
+		Expected: `ALTER TABLE "fktable" VALIDATE CONSTRAINT fkdd`,
+	})
+	DoTest(t, DeparseTest{
+		Query:    `ALTER TABLE ONLY test_drop_constr_parent DROP CONSTRAINT IF EXISTS test_drop_constr_parent_c_check CASCADE;`,
+		Expected: `ALTER TABLE ONLY "test_drop_constr_parent" DROP CONSTRAINT IF EXISTS test_drop_constr_parent_c_check CASCADE`,
+	})
+}
+
+func Test_AlterTableStmt_Partitions(t *testing.T) {
+	DoTest(t, DeparseTest{
+		Query:    `ALTER TABLE part_7 ATTACH PARTITION part_7_a_null FOR VALUES IN ('a', null);`,
+		Expected: `ALTER TABLE "part_7" ATTACH PARTITION "part_7_a_null" FOR VALUES IN ('a', NULL)`,
+	})
+	DoTest(t, DeparseTest{
+		Query:    `ALTER TABLE range_parted ATTACH PARTITION part1 FOR VALUES FROM (1, 1) TO (1, 10);`,
+		Expected: `ALTER TABLE "range_parted" ATTACH PARTITION "part1" FOR VALUES FROM (1, 1) TO (1, 10)`,
+	})
+	DoTest(t, DeparseTest{
+		Query:    `ALTER TABLE list_parted2 DETACH PARTITION part_3_4;`,
+		Expected: `ALTER TABLE "list_parted2" DETACH PARTITION "part_3_4"`,
+	})
+}
+
+func Test_AlterTableStmt_Storage(t *testing.T) {
+	DoTest(t, DeparseTest{
+		Query:    `ALTER TABLE not_owned_by_me OWNER TO regress_test_not_me;`,
+		Expected: `ALTER TABLE "not_owned_by_me" OWNER TO regress_test_not_me`,
+	})
+	DoTest(t, DeparseTest{
+		Query:    `ALTER TABLE owned OWNER TO CURRENT_USER;`,
+		Expected: `ALTER TABLE "owned" OWNER TO CURRENT_USER`,
+	})
+	DoTest(t, DeparseTest{
+		Query:    `ALTER TABLE logged1 SET UNLOGGED, SET WITH OIDS, SET WITHOUT CLUSTER;`,
+		Expected: `ALTER TABLE "logged1" SET UNLOGGED, SET WITH OIDS, SET WITHOUT CLUSTER`,
+	})
+	DoTest(t, DeparseTest{
+		Query:    `ALTER TABLE tmp SET TABLESPACE pg_default, CLUSTER ON tmp_idx;`,
+		Expected: `ALTER TABLE "tmp" SET TABLESPACE pg_default, CLUSTER ON tmp_idx`,
+	})
+	DoTest(t, DeparseTest{
+		Query:    `ALTER TABLE tmp SET (fillfactor = 70, toast.autovacuum_enabled = false);`,
+		Expected: `ALTER TABLE "tmp" SET (fillfactor=70, toast.autovacuum_enabled='false')`,
+	})
+	DoTest(t, DeparseTest{
+		Query:    `ALTER TABLE tmp RESET (fillfactor);`,
+		Expected: `ALTER TABLE "tmp" RESET (fillfactor)`,
+	})
+	DoTest(t, DeparseTest{
+		Query:    `ALTER TABLE part_2 INHERIT inh_test, NO INHERIT other;`,
+		Expected: `ALTER TABLE "part_2" INHERIT "inh_test", NO INHERIT "other"`,
+	})
+	DoTest(t, DeparseTest{
+		Query:    `ALTER TABLE tt7 NOT OF;`,
+		Expected: `ALTER TABLE "tt7" NOT OF`,
+	})
+	DoTest(t, DeparseTest{
+		Query:    `ALTER TABLE tt7 OF tt_t1;`,
+		Expected: `ALTER TABLE "tt7" OF tt_t1`,
+	})
+}
+
+func Test_AlterTableStmt_TriggersAndSecurity(t *testing.T) {
+	DoTest(t, DeparseTest{
+		Query:    `ALTER TABLE tmp ENABLE TRIGGER trig, DISABLE TRIGGER ALL, ENABLE ALWAYS TRIGGER other, ENABLE REPLICA RULE r;`,
+		Expected: `ALTER TABLE "tmp" ENABLE TRIGGER trig, DISABLE TRIGGER ALL, ENABLE ALWAYS TRIGGER other, ENABLE REPLICA RULE r`,
+	})
+	DoTest(t, DeparseTest{
+		Query:    `ALTER TABLE tmp DISABLE TRIGGER USER;`,
+		Expected: `ALTER TABLE "tmp" DISABLE TRIGGER USER`,
+	})
+	DoTest(t, DeparseTest{
+		Query:    `ALTER TABLE tmp ENABLE ROW LEVEL SECURITY, FORCE ROW LEVEL SECURITY;`,
+		Expected: `ALTER TABLE "tmp" ENABLE ROW LEVEL SECURITY, FORCE ROW LEVEL SECURITY`,
+	})
+	DoTest(t, DeparseTest{
+		Query:    `ALTER TABLE tmp REPLICA IDENTITY FULL;`,
+		Expected: `ALTER TABLE "tmp" REPLICA IDENTITY FULL`,
+	})
+	DoTest(t, DeparseTest{
+		Query:    `ALTER TABLE tmp REPLICA IDENTITY USING INDEX tmp_pkey;`,
+		Expected: `ALTER TABLE "tmp" REPLICA IDENTITY USING INDEX tmp_pkey`,
+	})
+}
+
+func Test_AlterTableStmt_OtherRelations(t *testing.T) {
+	DoTest(t, DeparseTest{
+		Query:    `ALTER INDEX IF EXISTS idx SET TABLESPACE fast;`,
+		Expected: `ALTER INDEX IF EXISTS "idx" SET TABLESPACE fast`,
+	})
+	DoTest(t, DeparseTest{
+		Query:    `ALTER MATERIALIZED VIEW mv OWNER TO SESSION_USER;`,
+		Expected: `ALTER MATERIALIZED VIEW "mv" OWNER TO SESSION_USER`,
+	})
+	DoTest(t, DeparseTest{
+		Query:    `ALTER TYPE test_type ADD ATTRIBUTE c text, DROP ATTRIBUTE IF EXISTS d CASCADE;`,
+		Expected: `ALTER TYPE "test_type" ADD ATTRIBUTE c text, DROP ATTRIBUTE IF EXISTS d CASCADE`,
+	})
+	DoTest(t, DeparseTest{
+		Query:    `ALTER TYPE test_type ALTER ATTRIBUTE b TYPE varchar;`,
+		Expected: `ALTER TYPE "test_type" ALTER ATTRIBUTE b TYPE varchar`,
+	})
+}
+
+func Test_AlterTableStmt_Identity(t *testing.T) {
+	DoTest(t, DeparseTest{
+		Query:    `ALTER TABLE itest4 ALTER COLUMN a DROP IDENTITY IF EXISTS;`,
+		Expected: `ALTER TABLE "itest4" ALTER COLUMN a DROP IDENTITY IF EXISTS`,
+	})
+	DoTest(t, DeparseTest{
+		Query:    `ALTER TABLE itest4 ALTER COLUMN a SET GENERATED BY DEFAULT SET INCREMENT BY 2 RESTART WITH 100;`,
+		Expected: `ALTER TABLE "itest4" ALTER COLUMN a SET GENERATED BY DEFAULT SET INCREMENT BY 2 RESTART WITH 100`,
+	})
+}
+
+func Test_AlterTableStmt_QuotedNames(t *testing.T) {
+	DoTest(t, DeparseTest{
+		Query:    `ALTER TABLE t ALTER COLUMN "Mixed" SET NOT NULL, DROP COLUMN "select", DROP COLUMN "........pg.dropped.1........", VALIDATE CONSTRAINT "T_check", ENABLE TRIGGER "Trg", CLUSTER ON "Idx", ALTER CONSTRAINT "Fk" DEFERRABLE;`,
+		Expected: `ALTER TABLE "t" ALTER COLUMN "Mixed" SET NOT NULL, DROP COLUMN "select", DROP COLUMN "........pg.dropped.1........", VALIDATE CONSTRAINT "T_check", ENABLE TRIGGER "Trg", CLUSTER ON "Idx", ALTER CONSTRAINT "Fk" DEFERRABLE INITIALLY IMMEDIATE`,
+	})
+}
+
+func Test_AlterTableStmt_ReplicaIdentityIndex(t *testing.T) {
+	DoTest(t, DeparseTest{
+		Query:    `ALTER TABLE t REPLICA IDENTITY USING INDEX "T_pkey";`,
+		Expected: `ALTER TABLE "t" REPLICA IDENTITY USING INDEX "T_pkey"`,
+	})
+}
+
+func Test_AlterTableStmt_OwnerQuoted(t *testing.T) {
+	DoTest(t, DeparseTest{
+		Query:    `ALTER TABLE t OWNER TO "Bob";`,
+		Expected: `ALTER TABLE "t" OWNER TO "Bob"`,
+	})
+}
+
+func Test_AlterTableStmt_AddQuotedColumn(t *testing.T) {
+	DoTest(t, DeparseTest{
+		Query:    `ALTER TABLE t ADD COLUMN "Col" int, ADD COLUMN "select" text`,
+		Expected: `ALTER TABLE "t" ADD COLUMN "Col" int, ADD COLUMN "select" text`,
+	})
+}
@@ -2,6 +2,66 @@
 
 package pg_query
 
+import (
+	"fmt"
+	"strings"
+
+	"github.com/juju/errors"
+)
+
 func (node AlterTSConfigurationStmt) Deparse(ctx Context) (*string, error) {
-	panic("Not Implemented")
+	out := []string{"ALTER TEXT SEARCH CONFIGURATION"}
+	if name, err := node.Cfgname.DeparseList(Context_None); err != nil {
+		return nil, err
+	} else {
+		out = append(out, strings.Join(name, "."))
+	}
+
+	dicts := make([]string, len(node.Dicts.Items))
+	for i, item := range node.Dicts.Items {
+		dict, ok := item.(List)
+		if !ok {
+			return nil, errors.Errorf("cannot deparse text search dictionary name of type %T", item)
+		}
+
+		if name, err := dict.DeparseList(Context_None); err != nil {
+			return nil, err
+		} else {
+			dicts[i] = strings.Join(name, ".")
+		}
+	}
+
+	tokens, err := node.Tokentype.DeparseList(Context_FuncCall)
+	if err != nil {
+		return nil, err
+	}
+	forTokens := fmt.Sprintf("FOR %s", strings.Join(tokens, ", "))
+
+	switch node.Kind {
+	case ALTER_TSCONFIG_ADD_MAPPING:
+		out = append(out, "ADD MAPPING", forTokens, "WITH", strings.Join(dicts, ", "))
+	case ALTER_TSCONFIG_ALTER_MAPPING_FOR_TOKEN:
+		out = append(out, "ALTER MAPPING", forTokens, "WITH", strings.Join(dicts, ", "))
+	case ALTER_TSCONFIG_REPLACE_DICT, ALTER_TSCONFIG_REPLACE_DICT_FOR_TOKEN:
+		if len(dicts) != 2 {
+			return nil, errors.New("replacing a text search dictionary requires the old and new dictionary")
+		}
+
+		out = append(out, "ALTER MAPPING")
+		if node.Kind == ALTER_TSCONFIG_REPLACE_DICT_FOR_TOKEN {
+			out = append(out, forTokens)
+		}
+		out = append(out, "REPLACE", dicts[0], "WITH", dicts[1])
+	case ALTER_TSCONFIG_DROP_MAPPING:
+		out = append(out, "DROP MAPPING")
+		if node.MissingOk {
+			out = append(out, "IF EXISTS")
+		}
+		out = append(out, forTokens)
+	default:
+		return nil, errors.Errorf("cannot deparse alter text search configuration kind (%d)", node.Kind)
+	}
+
+	result := strings.Join(out, " ")
+	return &result, nil
 }
@@ -0,0 +1,70 @@
+/*
+ * Copyright (c) 2019 Ready Stock
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+package pg_query
+
+import (
+	"testing"
+)
+
+func Test_AlterTSConfiguration_AddMapping(t *testing.T) {
+	DoTest(t, DeparseTest{
+		Query:    `ALTER TEXT SEARCH CONFIGURATION hunspell_tst ADD MAPPING FOR asciiword, word WITH ispell, english_stem;`,
+		Expected: `ALTER TEXT SEARCH CONFIGURATION "hunspell_tst" ADD MAPPING FOR asciiword, word WITH "ispell", "english_stem"`,
+	})
+}
+
+func Test_AlterTSConfiguration_AlterMapping(t *testing.T) {
+	DoTest(t, DeparseTest{
+		Query:    `ALTER TEXT SEARCH CONFIGURATION public.hunspell_tst ALTER MAPPING FOR asciiword WITH hunspell_long, english_stem;`,
+		Expected: `ALTER TEXT SEARCH CONFIGURATION "public"."hunspell_tst" ALTER MAPPING FOR asciiword WITH "hunspell_long", "english_stem"`,
+	})
+}
+
+func Test_AlterTSConfiguration_Replace(t *testing.T) {
+	DoTest(t, DeparseTest{
+		Query:    `ALTER TEXT SEARCH CONFIGURATION hunspell_tst ALTER MAPPING REPLACE ispell WITH hunspell;`,
+		Expected: `ALTER TEXT SEARCH CONFIGURATION "hunspell_tst" ALTER MAPPING REPLACE "ispell" WITH "hunspell"`,
+	})
+}
+
+func Test_AlterTSConfiguration_ReplaceForToken(t *testing.T) {
+	DoTest(t, DeparseTest{
+		Query:    `ALTER TEXT SEARCH CONFIGURATION hunspell_tst ALTER MAPPING FOR word REPLACE ispell WITH public.hunspell;`,
+		Expected: `ALTER TEXT SEARCH CONFIGURATION "hunspell_tst" ALTER MAPPING FOR word REPLACE "ispell" WITH "public"."hunspell"`,
+	})
+}
+
+func Test_AlterTSConfiguration_DropMapping(t *testing.T) {
+	DoTest(t, DeparseTest{
+		Query:    `ALTER TEXT SEARCH CONFIGURATION hunspell_tst DROP MAPPING IF EXISTS FOR asciiword, word;`,
+		Expected: `ALTER TEXT SEARCH CONFIGURATION "hunspell_tst" DROP MAPPING IF EXISTS FOR asciiword, word`,
+	})
+}
+
+func Test_AlterTSDictionary(t *testing.T) {
+	DoTest(t, DeparseTest{
+		Query:    `ALTER TEXT SEARCH DICTIONARY synonym (CaseSensitive = 1, Accept);`,
+		Expected: `ALTER TEXT SEARCH DICTIONARY "synonym" (casesensitive = 1, accept)`,
+	})
+}
+
+func Test_AlterCollation(t *testing.T) {
+	DoTest(t, DeparseTest{
+		Query:    `ALTER COLLATION public."en_US" REFRESH VERSION;`,
+		Expected: `ALTER COLLATION "public"."en_US" REFRESH VERSION`,
+	})
+}
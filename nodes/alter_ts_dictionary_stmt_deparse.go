@@ -2,6 +2,24 @@
 
 package pg_query
 
+import (
+	"strings"
+)
+
 func (node AlterTSDictionaryStmt) Deparse(ctx Context) (*string, error) {
-	panic("Not Implemented")
+	out := []string{"ALTER TEXT SEARCH DICTIONARY"}
+	if name, err := node.Dictname.DeparseList(Context_None); err != nil {
+		return nil, err
+	} else {
+		out = append(out, strings.Join(name, "."))
+	}
+
+	if str, err := deparseDefinition(node.Options); err != nil {
+		return nil, err
+	} else {
+		out = append(out, *str)
+	}
+
+	result := strings.Join(out, " ")
+	return &result, nil
 }
@@ -2,6 +2,34 @@
 
 package pg_query
 
+import (
+	"strings"
+
+	"github.com/juju/errors"
+)
+
 func (node AlterUserMappingStmt) Deparse(ctx Context) (*string, error) {
-	panic("Not Implemented")
+	if node.User == nil || node.Servername == nil {
+		return nil, errors.New("user and server cannot be null in alter user mapping")
+	}
+
+	out := []string{"ALTER USER MAPPING"}
+	if str, err := deparseNode(*node.User, Context_None); err != nil {
+		return nil, err
+	} else {
+		out = append(out, "FOR", *str)
+	}
+
+	out = append(out, "SERVER", quoteIdentifier(*node.Servername))
+
+	if node.Options.Items != nil && len(node.Options.Items) > 0 {
+		if str, err := deparseGenericOptions(node.Options); err != nil {
+			return nil, err
+		} else {
+			out = append(out, *str)
+		}
+	}
+
+	result := strings.Join(out, " ")
+	return &result, nil
 }
@@ -2,6 +2,14 @@
 
 package pg_query
 
+import (
+	"fmt"
+	"strings"
+)
+
 func (node BitString) Deparse(ctx Context) (*string, error) {
-	panic("Not Implemented")
+	// The parser keeps the leading b or x to tell binary and hexadecimal
+	// strings apart.
+	result := fmt.Sprintf("%s'%s'", strings.ToUpper(node.Str[:1]), node.Str[1:])
+	return &result, nil
 }
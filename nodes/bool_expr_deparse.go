@@ -36,7 +36,7 @@ func (node BoolExpr) deparseBoolExprNot() (*string, error) {
 	}
 
 	if len(items) > 1 {
-		panic("cannot handle more than 1 arg in `not` expression")
+		return nil, errors.New("cannot deparse more than 1 arg in `not` expression")
 	}
 
 	out = append(out, items...)
@@ -0,0 +1,28 @@
+/*
+ * Copyright (c) 2019 Ready Stock
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+package pg_query
+
+/*
+ * The remaining boolean tests, they follow IS_TRUE and IS_NOT_TRUE in the
+ * BoolTestType enum of primnodes.h.
+ */
+const (
+	IS_FALSE BoolTestType = iota + IS_NOT_TRUE + 1
+	IS_NOT_FALSE
+	IS_UNKNOWN
+	IS_NOT_UNKNOWN
+)
@@ -2,6 +2,49 @@
 
 package pg_query
 
+import (
+	"fmt"
+	"strings"
+
+	"github.com/juju/errors"
+)
+
+var (
+	boolTestTypes = map[BoolTestType]string{
+		IS_TRUE:        "IS TRUE",
+		IS_NOT_TRUE:    "IS NOT TRUE",
+		IS_FALSE:       "IS FALSE",
+		IS_NOT_FALSE:   "IS NOT FALSE",
+		IS_UNKNOWN:     "IS UNKNOWN",
+		IS_NOT_UNKNOWN: "IS NOT UNKNOWN",
+	}
+)
+
 func (node BooleanTest) Deparse(ctx Context) (*string, error) {
-	panic("Not Implemented")
+	out := make([]string, 0)
+	if node.Arg == nil {
+		return nil, errors.New("argument cannot be null for boolean test")
+	}
+
+	// The test binds tighter than any operator, so those have to be
+	// parenthesized to keep them together.
+	if str, err := deparseNode(node.Arg, Context_None); err != nil {
+		return nil, err
+	} else {
+		switch node.Arg.(type) {
+		case A_Expr, BoolExpr:
+			out = append(out, fmt.Sprintf("(%s)", *str))
+		default:
+			out = append(out, *str)
+		}
+	}
+
+	if test, ok := boolTestTypes[node.Booltesttype]; !ok {
+		return nil, errors.Errorf("cannot deparse boolean test type (%d)", node.Booltesttype)
+	} else {
+		out = append(out, test)
+	}
+
+	result := strings.Join(out, " ")
+	return &result, nil
 }
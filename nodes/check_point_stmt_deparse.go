@@ -3,5 +3,6 @@
 package pg_query
 
 func (node CheckPointStmt) Deparse(ctx Context) (*string, error) {
-	panic("Not Implemented")
+	result := "CHECKPOINT"
+	return &result, nil
 }
@@ -2,6 +2,20 @@
 
 package pg_query
 
+import (
+	"strings"
+)
+
 func (node ClosePortalStmt) Deparse(ctx Context) (*string, error) {
-	panic("Not Implemented")
+	out := []string{"CLOSE"}
+
+	// Without a name all open cursors are closed.
+	if node.Portalname == nil {
+		out = append(out, "ALL")
+	} else {
+		out = append(out, quoteIdentifier(*node.Portalname))
+	}
+
+	result := strings.Join(out, " ")
+	return &result, nil
 }
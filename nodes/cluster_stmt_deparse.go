@@ -2,6 +2,29 @@
 
 package pg_query
 
+import (
+	"strings"
+)
+
 func (node ClusterStmt) Deparse(ctx Context) (*string, error) {
-	panic("Not Implemented")
+	out := []string{"CLUSTER"}
+	if node.Verbose {
+		out = append(out, "VERBOSE")
+	}
+
+	// Without a relation all previously clustered tables are clustered again.
+	if node.Relation != nil {
+		if str, err := deparseNode(*node.Relation, Context_None); err != nil {
+			return nil, err
+		} else {
+			out = append(out, *str)
+		}
+
+		if node.Indexname != nil {
+			out = append(out, "USING", quoteIdentifier(*node.Indexname))
+		}
+	}
+
+	result := strings.Join(out, " ")
+	return &result, nil
 }
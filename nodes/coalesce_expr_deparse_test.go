@@ -0,0 +1,98 @@
+/*
+ * Copyright (c) 2019 Ready Stock
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+package pg_query
+
+import (
+	"testing"
+)
+
+func Test_CoalesceExpr(t *testing.T) {
+	DoTest(t, DeparseTest{
+		Query:    `SELECT COALESCE(a, b, 0), NULLIF(a, b), GREATEST(1, 2), LEAST(a, 3) FROM t;`,
+		Expected: `SELECT COALESCE("a", "b", 0), NULLIF("a", "b"), GREATEST(1, 2), LEAST("a", 3) FROM "t"`,
+	})
+}
+
+func Test_BooleanTest(t *testing.T) {
+	DoTest(t, DeparseTest{
+		Query:    `SELECT * FROM t WHERE a IS TRUE OR b IS NOT TRUE OR c IS FALSE OR d IS NOT FALSE OR e IS UNKNOWN OR f IS NOT UNKNOWN;`,
+		Expected: `SELECT * FROM "t" WHERE "a" IS TRUE OR "b" IS NOT TRUE OR "c" IS FALSE OR "d" IS NOT FALSE OR "e" IS UNKNOWN OR "f" IS NOT UNKNOWN`,
+	})
+}
+
+func Test_BooleanTest_Expression(t *testing.T) {
+	DoTest(t, DeparseTest{
+		Query:    `SELECT (a = b) IS NOT TRUE FROM t;`,
+		Expected: `SELECT ("a" = "b") IS NOT TRUE FROM "t"`,
+	})
+}
+
+func Test_SQLValueFunction(t *testing.T) {
+	DoTest(t, DeparseTest{
+		Query:    `SELECT CURRENT_DATE, CURRENT_TIME, CURRENT_TIME(2), CURRENT_TIMESTAMP, LOCALTIME(3), LOCALTIMESTAMP, CURRENT_ROLE, CURRENT_USER, USER, SESSION_USER, CURRENT_CATALOG, CURRENT_SCHEMA;`,
+		Expected: `SELECT CURRENT_DATE, CURRENT_TIME, CURRENT_TIME(2), CURRENT_TIMESTAMP, LOCALTIME(3), LOCALTIMESTAMP, CURRENT_ROLE, CURRENT_USER, USER, SESSION_USER, CURRENT_CATALOG, CURRENT_SCHEMA`,
+	})
+}
+
+func Test_BitString(t *testing.T) {
+	DoTest(t, DeparseTest{
+		Query:    `SELECT B'1011', X'1F';`,
+		Expected: `SELECT B'1011', X'1F'`,
+	})
+}
+
+func Test_NamedArgExpr(t *testing.T) {
+	DoTest(t, DeparseTest{
+		Query:    `SELECT f(a => 1, b := 'x');`,
+		Expected: `SELECT f(a => 1, b => 'x')`,
+	})
+}
+
+func Test_GroupingSet(t *testing.T) {
+	DoTest(t, DeparseTest{
+		Query:    `SELECT a, b, GROUPING(a, b), sum(c) FROM t GROUP BY ROLLUP (a, (b, c)), CUBE (a), GROUPING SETS ((), a);`,
+		Expected: `SELECT "a", "b", GROUPING("a", "b"), sum("c") FROM "t" GROUP BY ROLLUP ("a", ("b", "c")), CUBE ("a"), GROUPING SETS ((), "a")`,
+	})
+}
+
+func Test_CurrentOfExpr(t *testing.T) {
+	DoTest(t, DeparseTest{
+		Query:    `DELETE FROM t WHERE CURRENT OF c;`,
+		Expected: `DELETE FROM "t" WHERE CURRENT OF c`,
+	})
+}
+
+func Test_ResTarget_QuotedAlias(t *testing.T) {
+	DoTest(t, DeparseTest{
+		Query:    `SELECT 1 AS "Foo", 2 AS "select", 3 AS "from"`,
+		Expected: `SELECT 1 AS "Foo", 2 AS select, 3 AS from`,
+	})
+}
+
+func Test_UpdateStmt_QuotedColumn(t *testing.T) {
+	DoTest(t, DeparseTest{
+		Query:    `UPDATE t SET "A" = 1, "user" = 2`,
+		Expected: `UPDATE "t" SET "A" = 1, "user" = 2`,
+	})
+}
+
+func Test_CurrentOfExpr_QuotedCursor(t *testing.T) {
+	DoTest(t, DeparseTest{
+		Query:    `DELETE FROM t WHERE CURRENT OF "C"`,
+		Expected: `DELETE FROM "t" WHERE CURRENT OF "C"`,
+	})
+}
@@ -3,5 +3,5 @@
 package pg_query
 
 func (node CoerceViaIO) Deparse(ctx Context) (*string, error) {
-	panic("Not Implemented")
+	return nil, newUnsupportedNodeError(node)
 }
@@ -2,6 +2,37 @@
 
 package pg_query
 
+import (
+	"fmt"
+	"strings"
+)
+
 func (node CollateClause) Deparse(ctx Context) (*string, error) {
-	panic("Not Implemented")
+	out := make([]string, 0)
+	if node.Arg != nil {
+		if str, err := deparseNode(node.Arg, Context_None); err != nil {
+			return nil, err
+		} else {
+			// COLLATE binds tighter than any operator, so everything but simple
+			// operands has to be parenthesized to keep it applying to the whole
+			// expression.
+			switch node.Arg.(type) {
+			case ColumnRef, A_Const, FuncCall, ParamRef:
+				out = append(out, *str)
+			default:
+				out = append(out, fmt.Sprintf("(%s)", *str))
+			}
+		}
+	}
+
+	out = append(out, "COLLATE")
+
+	if names, err := node.Collname.DeparseList(Context_None); err != nil {
+		return nil, err
+	} else {
+		out = append(out, strings.Join(names, "."))
+	}
+
+	result := strings.Join(out, " ")
+	return &result, nil
 }
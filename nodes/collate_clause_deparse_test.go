@@ -0,0 +1,35 @@
+/*
+ * Copyright (c) 2019 Ready Stock
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+package pg_query
+
+import (
+	"testing"
+)
+
+func Test_CollateClause_Column(t *testing.T) {
+	DoTest(t, DeparseTest{
+		Query:    `SELECT a COLLATE "C" FROM t;`,
+		Expected: `SELECT "a" COLLATE "C" FROM "t"`,
+	})
+}
+
+func Test_CollateClause_Expression(t *testing.T) {
+	DoTest(t, DeparseTest{
+		Query:    `SELECT (a || b) COLLATE "C" FROM t;`,
+		Expected: `SELECT ("a" || "b") COLLATE "C" FROM "t"`,
+	})
+}
@@ -4,17 +4,42 @@ package pg_query
 
 import (
 	"strings"
+
+	"github.com/juju/errors"
 )
 
 func (node ColumnDef) Deparse(ctx Context) (*string, error) {
-	out := []string{*node.Colname}
+	if node.Colname == nil {
+		return nil, errors.New("column name cannot be null")
+	}
 
-	if str, err := deparseNode(*node.TypeName, Context_None); err != nil {
+	out := []string{quoteIdentifier(*node.Colname)}
+
+	// Columns of typed tables and partitions only specify their options.
+	if node.TypeName == nil {
+		out = append(out, "WITH OPTIONS")
+	} else if str, err := deparseNode(*node.TypeName, Context_None); err != nil {
 		return nil, err
 	} else {
 		out = append(out, *str)
 	}
 
+	if node.Fdwoptions.Items != nil && len(node.Fdwoptions.Items) > 0 {
+		if str, err := deparseGenericOptions(node.Fdwoptions); err != nil {
+			return nil, err
+		} else {
+			out = append(out, *str)
+		}
+	}
+
+	if node.CollClause != nil {
+		if str, err := deparseNode(*node.CollClause, Context_None); err != nil {
+			return nil, err
+		} else {
+			out = append(out, *str)
+		}
+	}
+
 	if node.RawDefault != nil {
 		out = append(out, "USING")
 		if str, err := deparseNode(node.RawDefault, Context_None); err != nil {
@@ -2,6 +2,31 @@
 
 package pg_query
 
+import (
+	"strings"
+)
+
 func (node CommentStmt) Deparse(ctx Context) (*string, error) {
-	panic("Not Implemented")
+	out := []string{"COMMENT ON"}
+	if str, err := deparseObjectType(node.Objtype); err != nil {
+		return nil, err
+	} else {
+		out = append(out, *str)
+	}
+
+	if str, err := deparseObjectName(node.Objtype, nil, node.Object); err != nil {
+		return nil, err
+	} else {
+		out = append(out, *str)
+	}
+
+	// A missing comment removes the existing comment.
+	if node.Comment == nil {
+		out = append(out, "IS NULL")
+	} else {
+		out = append(out, "IS", quoteLiteral(*node.Comment))
+	}
+
+	result := strings.Join(out, " ")
+	return &result, nil
 }
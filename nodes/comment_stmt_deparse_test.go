@@ -0,0 +1,154 @@
+/*
+ * Copyright (c) 2019 Ready Stock
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+package pg_query
+
+import (
+	"testing"
+)
+
+func Test_Comment_Table(t *testing.T) {
+	DoTest(t, DeparseTest{
+		Query:    `COMMENT ON TABLE public.t IS 'Customer ''accounts''';`,
+		Expected: `COMMENT ON TABLE "public"."t" IS 'Customer ''accounts'''`,
+	})
+}
+
+func Test_Comment_Column(t *testing.T) {
+	DoTest(t, DeparseTest{
+		Query:    `COMMENT ON COLUMN public.t.a IS NULL;`,
+		Expected: `COMMENT ON COLUMN "public"."t"."a" IS NULL`,
+	})
+}
+
+func Test_Comment_Function(t *testing.T) {
+	DoTest(t, DeparseTest{
+		Query:    `COMMENT ON FUNCTION f(int) IS 'adds one';`,
+		Expected: `COMMENT ON FUNCTION f(int) IS 'adds one'`,
+	})
+}
+
+func Test_Comment_Aggregate(t *testing.T) {
+	DoTest(t, DeparseTest{
+		Query:    `COMMENT ON AGGREGATE cnt(*) IS 'count';`,
+		Expected: `COMMENT ON AGGREGATE cnt(*) IS 'count'`,
+	})
+}
+
+func Test_Comment_Operator(t *testing.T) {
+	DoTest(t, DeparseTest{
+		Query:    `COMMENT ON OPERATOR - (NONE, int) IS 'negate';`,
+		Expected: `COMMENT ON OPERATOR -(NONE, int) IS 'negate'`,
+	})
+}
+
+func Test_Comment_Cast(t *testing.T) {
+	DoTest(t, DeparseTest{
+		Query:    `COMMENT ON CAST (text AS int) IS 'cast';`,
+		Expected: `COMMENT ON CAST (text AS int) IS 'cast'`,
+	})
+}
+
+func Test_Comment_Transform(t *testing.T) {
+	DoTest(t, DeparseTest{
+		Query:    `COMMENT ON TRANSFORM FOR int LANGUAGE sql IS 'transform';`,
+		Expected: `COMMENT ON TRANSFORM FOR int LANGUAGE "sql" IS 'transform'`,
+	})
+}
+
+func Test_Comment_Constraint(t *testing.T) {
+	DoTest(t, DeparseTest{
+		Query:    `COMMENT ON CONSTRAINT t_check ON public.t IS 'constraint';`,
+		Expected: `COMMENT ON CONSTRAINT "t_check" ON "public"."t" IS 'constraint'`,
+	})
+}
+
+func Test_Comment_DomainConstraint(t *testing.T) {
+	DoTest(t, DeparseTest{
+		Query:    `COMMENT ON CONSTRAINT c ON DOMAIN posint IS 'constraint';`,
+		Expected: `COMMENT ON CONSTRAINT "c" ON DOMAIN posint IS 'constraint'`,
+	})
+}
+
+func Test_Comment_Trigger(t *testing.T) {
+	DoTest(t, DeparseTest{
+		Query:    `COMMENT ON TRIGGER tr ON t IS 'trigger';`,
+		Expected: `COMMENT ON TRIGGER "tr" ON "t" IS 'trigger'`,
+	})
+}
+
+func Test_Comment_OperatorClass(t *testing.T) {
+	DoTest(t, DeparseTest{
+		Query:    `COMMENT ON OPERATOR CLASS c USING btree IS 'class';`,
+		Expected: `COMMENT ON OPERATOR CLASS "c" USING "btree" IS 'class'`,
+	})
+}
+
+func Test_Comment_LargeObject(t *testing.T) {
+	DoTest(t, DeparseTest{
+		Query:    `COMMENT ON LARGE OBJECT 42 IS 'blob';`,
+		Expected: `COMMENT ON LARGE OBJECT 42 IS 'blob'`,
+	})
+}
+
+func Test_Comment_Type(t *testing.T) {
+	DoTest(t, DeparseTest{
+		Query:    `COMMENT ON TYPE public.pair IS 'pair';`,
+		Expected: `COMMENT ON TYPE public.pair IS 'pair'`,
+	})
+}
+
+func Test_Comment_Schema(t *testing.T) {
+	DoTest(t, DeparseTest{
+		Query:    `COMMENT ON SCHEMA app IS 'app';`,
+		Expected: `COMMENT ON SCHEMA "app" IS 'app'`,
+	})
+}
+
+func Test_Comment_TextSearchParser(t *testing.T) {
+	DoTest(t, DeparseTest{
+		Query:    `COMMENT ON TEXT SEARCH PARSER p IS 'parser';`,
+		Expected: `COMMENT ON TEXT SEARCH PARSER "p" IS 'parser'`,
+	})
+}
+
+func Test_Comment_EventTrigger(t *testing.T) {
+	DoTest(t, DeparseTest{
+		Query:    `COMMENT ON EVENT TRIGGER et IS 'event';`,
+		Expected: `COMMENT ON EVENT TRIGGER "et" IS 'event'`,
+	})
+}
+
+func Test_Comment_AccessMethod(t *testing.T) {
+	DoTest(t, DeparseTest{
+		Query:    `COMMENT ON ACCESS METHOD gist2 IS 'am';`,
+		Expected: `COMMENT ON ACCESS METHOD "gist2" IS 'am'`,
+	})
+}
+
+func Test_SecLabel(t *testing.T) {
+	DoTest(t, DeparseTest{
+		Query:    `SECURITY LABEL FOR selinux ON TABLE t IS 'system_u:object_r:sepgsql_table_t:s0';`,
+		Expected: `SECURITY LABEL FOR selinux ON TABLE "t" IS 'system_u:object_r:sepgsql_table_t:s0'`,
+	})
+}
+
+func Test_SecLabel_Remove(t *testing.T) {
+	DoTest(t, DeparseTest{
+		Query:    `SECURITY LABEL ON ROLE regress_user IS NULL;`,
+		Expected: `SECURITY LABEL ON ROLE "regress_user" IS NULL`,
+	})
+}
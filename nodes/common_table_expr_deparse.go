@@ -2,6 +2,39 @@
 
 package pg_query
 
+import (
+	"fmt"
+	"strings"
+
+	"github.com/juju/errors"
+)
+
 func (node CommonTableExpr) Deparse(ctx Context) (*string, error) {
-	panic("Not Implemented")
+	out := make([]string, 0)
+	if node.Ctename == nil {
+		return nil, errors.New("common table expression must have a name")
+	}
+
+	if str, err := (String{Str: *node.Ctename}).Deparse(Context_None); err != nil {
+		return nil, err
+	} else {
+		out = append(out, *str)
+	}
+
+	if node.Aliascolnames.Items != nil && len(node.Aliascolnames.Items) > 0 {
+		if names, err := node.Aliascolnames.DeparseList(Context_None); err != nil {
+			return nil, err
+		} else {
+			out = append(out, fmt.Sprintf("(%s)", strings.Join(names, ", ")))
+		}
+	}
+
+	if str, err := deparseNode(node.Ctequery, Context_None); err != nil {
+		return nil, err
+	} else {
+		out = append(out, fmt.Sprintf("AS (%s)", *str))
+	}
+
+	result := strings.Join(out, " ")
+	return &result, nil
 }
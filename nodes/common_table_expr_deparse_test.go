@@ -0,0 +1,87 @@
+/*
+ * Copyright (c) 2019 Ready Stock
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+package pg_query
+
+import (
+	"testing"
+)
+
+func Test_CommonTableExpr_Simple(t *testing.T) {
+	DoTest(t, DeparseTest{
+		Query:    `WITH q1(x,y) AS (SELECT 1,2) SELECT * FROM q1, q1 AS q2;`,
+		Expected: `WITH "q1" ("x", "y") AS (SELECT 1, 2) SELECT * FROM "q1", "q1" q2`,
+	})
+	DoTest(t, DeparseTest{
+		Query:    `WITH a AS (SELECT 1), b AS (SELECT 2) SELECT * FROM a UNION SELECT * FROM b;`,
+		Expected: `WITH "a" AS (SELECT 1), "b" AS (SELECT 2) SELECT * FROM "a" UNION SELECT * FROM "b"`,
+	})
+}
+
+func Test_CommonTableExpr_Recursive(t *testing.T) {
+	DoTest(t, DeparseTest{
+		Query:    `WITH RECURSIVE t(n) AS (VALUES (1) UNION ALL SELECT n+1 FROM t WHERE n < 100) SELECT sum(n) FROM t;`,
+		Expected: `WITH RECURSIVE "t" ("n") AS (VALUES (1) UNION ALL SELECT "n" + 1 FROM "t" WHERE "n" < 100) SELECT sum("n") FROM "t"`,
+	})
+	DoTest(t, DeparseTest{
+		Query:    `WITH RECURSIVE x(n) AS (SELECT 1 INTERSECT ALL SELECT n+1 FROM x) SELECT * FROM x;`,
+		Expected: `WITH RECURSIVE "x" ("n") AS (SELECT 1 INTERSECT ALL SELECT "n" + 1 FROM "x") SELECT * FROM "x"`,
+	})
+}
+
+func Test_CommonTableExpr_DataModifying(t *testing.T) {
+	DoTest(t, DeparseTest{
+		Query:    `WITH moved AS (DELETE FROM orders WHERE archived RETURNING *) INSERT INTO orders_archive SELECT * FROM moved;`,
+		Expected: `WITH "moved" AS (DELETE FROM "orders" WHERE "archived" RETURNING *) INSERT INTO "orders_archive" SELECT * FROM "moved"`,
+	})
+	DoTest(t, DeparseTest{
+		Query:    `WITH t AS (UPDATE y SET a = a + 1 RETURNING *) SELECT * FROM t;`,
+		Expected: `WITH "t" AS (UPDATE "y" SET a = "a" + 1 RETURNING *) SELECT * FROM "t"`,
+	})
+}
+
+func Test_SubLink_Exists(t *testing.T) {
+	DoTest(t, DeparseTest{
+		Query:    `SELECT * FROM tenk1 a WHERE EXISTS (SELECT 1 FROM tenk1 b WHERE b.unique1 = a.unique2);`,
+		Expected: `SELECT * FROM "tenk1" a WHERE EXISTS (SELECT 1 FROM "tenk1" b WHERE "b"."unique1" = "a"."unique2")`,
+	})
+}
+
+func Test_SubLink_AnyAll(t *testing.T) {
+	DoTest(t, DeparseTest{
+		Query:    `SELECT f1 FROM subselect_tbl WHERE f1 IN (SELECT f2 FROM subselect_tbl);`,
+		Expected: `SELECT "f1" FROM "subselect_tbl" WHERE "f1" IN (SELECT "f2" FROM "subselect_tbl")`,
+	})
+	DoTest(t, DeparseTest{
+		Query:    `SELECT f1 FROM subselect_tbl WHERE f1 = ANY (SELECT f2 FROM subselect_tbl);`,
+		Expected: `SELECT "f1" FROM "subselect_tbl" WHERE "f1" = ANY (SELECT "f2" FROM "subselect_tbl")`,
+	})
+	DoTest(t, DeparseTest{
+		Query:    `SELECT f1 FROM subselect_tbl WHERE f1 > ALL (SELECT f2 FROM subselect_tbl);`,
+		Expected: `SELECT "f1" FROM "subselect_tbl" WHERE "f1" > ALL (SELECT "f2" FROM "subselect_tbl")`,
+	})
+	DoTest(t, DeparseTest{
+		Query:    `SELECT f1 FROM subselect_tbl WHERE f1 OPERATOR(pg_catalog.<) ANY (SELECT f2 FROM subselect_tbl);`,
+		Expected: `SELECT "f1" FROM "subselect_tbl" WHERE "f1" OPERATOR(pg_catalog.<) ANY (SELECT "f2" FROM "subselect_tbl")`,
+	})
+}
+
+func Test_SubLink_Array(t *testing.T) {
+	DoTest(t, DeparseTest{
+		Query:    `SELECT ARRAY(SELECT f1 FROM subselect_tbl) AS arr;`,
+		Expected: `SELECT ARRAY(SELECT "f1" FROM "subselect_tbl") AS arr`,
+	})
+}
@@ -2,6 +2,36 @@
 
 package pg_query
 
+import (
+	"fmt"
+	"strings"
+
+	"github.com/juju/errors"
+)
+
 func (node CompositeTypeStmt) Deparse(ctx Context) (*string, error) {
-	panic("Not Implemented")
+	if node.Typevar == nil {
+		return nil, errors.New("type name cannot be null in create type")
+	}
+
+	out := []string{"CREATE TYPE"}
+
+	// The type name is stored as a relation but can never be inherited from, so
+	// it must be written without the ONLY keyword.
+	typevar := *node.Typevar
+	typevar.Inh = true
+	if str, err := deparseNode(typevar, Context_None); err != nil {
+		return nil, err
+	} else {
+		out = append(out, *str)
+	}
+
+	if columns, err := node.Coldeflist.DeparseList(Context_None); err != nil {
+		return nil, err
+	} else {
+		out = append(out, fmt.Sprintf("AS (%s)", strings.Join(columns, ", ")))
+	}
+
+	result := strings.Join(out, " ")
+	return &result, nil
 }
@@ -5,13 +5,29 @@ package pg_query
 import (
 	"fmt"
 	"strings"
+
+	"github.com/juju/errors"
+)
+
+var (
+	foreignKeyActions = map[byte]string{
+		'r': "RESTRICT",
+		'c': "CASCADE",
+		'n': "SET NULL",
+		'd': "SET DEFAULT",
+	}
+
+	foreignKeyMatchTypes = map[byte]string{
+		'f': "MATCH FULL",
+		'p': "MATCH PARTIAL",
+	}
 )
 
 func (node Constraint) Deparse(ctx Context) (*string, error) {
 	out := make([]string, 0)
 	if node.Conname != nil {
 		out = append(out, "CONSTRAINT")
-		out = append(out, *node.Conname)
+		out = append(out, quoteIdentifier(*node.Conname))
 	}
 	switch node.Contype {
 	case CONSTR_NULL:
@@ -27,18 +43,77 @@ func (node Constraint) Deparse(ctx Context) (*string, error) {
 	case CONSTR_UNIQUE:
 		out = append(out, "UNIQUE")
 	case CONSTR_EXCLUSION:
-		out = append(out, "EXCLUSION")
+		out = append(out, "EXCLUDE")
+		if node.AccessMethod != nil {
+			out = append(out, fmt.Sprintf("USING %s", quoteIdentifier(*node.AccessMethod)))
+		}
+
+		// Each exclusion is a pair of the index element and the name of the
+		// operator it is compared with.
+		exclusions := make([]string, 0)
+		for _, item := range node.Exclusions.Items {
+			pair, ok := item.(List)
+			if !ok || len(pair.Items) != 2 {
+				return nil, errors.New("exclusion must be a pair of element and operator")
+			}
+
+			opname, ok := pair.Items[1].(List)
+			if !ok {
+				return nil, errors.New("exclusion operator must be a list of names")
+			}
+
+			elem, err := deparseNode(pair.Items[0], Context_None)
+			if err != nil {
+				return nil, err
+			}
+
+			operator, err := opname.DeparseList(Context_Operator)
+			if err != nil {
+				return nil, err
+			}
+
+			exclusions = append(exclusions, fmt.Sprintf("%s WITH %s", *elem, strings.Join(operator, ".")))
+		}
+
+		out = append(out, fmt.Sprintf("(%s)", strings.Join(exclusions, ", ")))
+	case CONSTR_IDENTITY:
+		if node.GeneratedWhen == 'a' {
+			out = append(out, "GENERATED ALWAYS AS IDENTITY")
+		} else {
+			out = append(out, "GENERATED BY DEFAULT AS IDENTITY")
+		}
+
+		// The options of an identity column are those of its sequence.
+		if node.Options.Items != nil && len(node.Options.Items) > 0 {
+			if options, err := deparseSeqOptions(node.Options); err != nil {
+				return nil, err
+			} else {
+				out = append(out, fmt.Sprintf("(%s)", strings.Join(options, " ")))
+			}
+		}
 	case CONSTR_FOREIGN:
-		if node.Conname != nil {
+		// Column constraints only specify the referenced table, the FOREIGN KEY
+		// keyword is only used when the constraint lists its own columns.
+		if node.FkAttrs.Items != nil && len(node.FkAttrs.Items) > 0 {
 			out = append(out, "FOREIGN KEY")
 		}
+	case CONSTR_ATTR_DEFERRABLE:
+		out = append(out, "DEFERRABLE")
+	case CONSTR_ATTR_NOT_DEFERRABLE:
+		out = append(out, "NOT DEFERRABLE")
+	case CONSTR_ATTR_DEFERRED:
+		out = append(out, "INITIALLY DEFERRED")
+	case CONSTR_ATTR_IMMEDIATE:
+		out = append(out, "INITIALLY IMMEDIATE")
 	}
 
 	if node.RawExpr != nil {
 		if expr, err := deparseNode(node.RawExpr, Context_None); err != nil {
 			return nil, err
 		} else {
-			if aexpr, ok := node.RawExpr.(A_Expr); ok && aexpr.Kind == AEXPR_OP {
+			if node.Contype == CONSTR_CHECK {
+				out = append(out, fmt.Sprintf("(%s)", *expr))
+			} else if aexpr, ok := node.RawExpr.(A_Expr); ok && aexpr.Kind == AEXPR_OP {
 				out = append(out, fmt.Sprintf("(%s)", *expr))
 			} else {
 				out = append(out, *expr)
@@ -46,6 +121,10 @@ func (node Constraint) Deparse(ctx Context) (*string, error) {
 		}
 	}
 
+	if node.IsNoInherit {
+		out = append(out, "NO INHERIT")
+	}
+
 	if node.Keys.Items != nil && len(node.Keys.Items) > 0 {
 		if list, err := deparseNodeList(node.Keys.Items, Context_None); err != nil {
 			return nil, err
@@ -63,34 +142,72 @@ func (node Constraint) Deparse(ctx Context) (*string, error) {
 	}
 
 	if node.Pktable != nil {
-		if list, err := deparseNodeList(node.PkAttrs.Items, Context_None); err != nil {
+		if pk, err := deparseNode(*node.Pktable, Context_None); err != nil {
 			return nil, err
 		} else {
-			if pk, err := deparseNode(node.Pktable, Context_None); err != nil {
+			out = append(out, fmt.Sprintf("REFERENCES %s", *pk))
+		}
+
+		if node.PkAttrs.Items != nil && len(node.PkAttrs.Items) > 0 {
+			if list, err := deparseNodeList(node.PkAttrs.Items, Context_None); err != nil {
 				return nil, err
 			} else {
-				out = append(out, fmt.Sprintf("REFERENCES %s (%s)", *pk, strings.Join(list, ", ")))
+				out = append(out, fmt.Sprintf("(%s)", strings.Join(list, ", ")))
 			}
+		}
 
-			switch node.FkDelAction {
-			case 97: // Default (NO ACTION)
-			case 99: // On Delete cascade
-				out = append(out, "ON DELETE CASCADE")
-			case 110:
-				out = append(out, "ON DELETE SET NULL")
-			case 114: // On Delete Restrict
-				out = append(out, "ON DELETE RESTRICT")
-			}
+		if match, ok := foreignKeyMatchTypes[node.FkMatchtype]; ok {
+			out = append(out, match)
+		}
+
+		// The default action of NO ACTION does not need to be included.
+		if action, ok := foreignKeyActions[node.FkDelAction]; ok {
+			out = append(out, fmt.Sprintf("ON DELETE %s", action))
+		}
+
+		if action, ok := foreignKeyActions[node.FkUpdAction]; ok {
+			out = append(out, fmt.Sprintf("ON UPDATE %s", action))
 		}
 	}
 
-	if node.SkipValidation {
-		out = append(out, "NOT VALID")
+	if node.Contype != CONSTR_IDENTITY && node.Options.Items != nil && len(node.Options.Items) > 0 {
+		if str, err := deparseRelOptions(node.Options); err != nil {
+			return nil, err
+		} else {
+			out = append(out, fmt.Sprintf("WITH %s", *str))
+		}
 	}
 
 	if node.Indexname != nil {
-		out = append(out, fmt.Sprintf("USING INDEX %s", *node.Indexname))
+		out = append(out, fmt.Sprintf("USING INDEX %s", quoteIdentifier(*node.Indexname)))
+	}
+
+	if node.Indexspace != nil {
+		out = append(out, fmt.Sprintf("USING INDEX TABLESPACE %s", quoteIdentifier(*node.Indexspace)))
 	}
+
+	// Column constraints specify these attributes as separate constraints
+	// instead, which are handled above.
+	if node.Deferrable {
+		out = append(out, "DEFERRABLE")
+	}
+
+	if node.Initdeferred {
+		out = append(out, "INITIALLY DEFERRED")
+	}
+
+	if node.Contype == CONSTR_EXCLUSION && node.WhereClause != nil {
+		if str, err := deparseNode(node.WhereClause, Context_None); err != nil {
+			return nil, err
+		} else {
+			out = append(out, fmt.Sprintf("WHERE (%s)", *str))
+		}
+	}
+
+	if node.SkipValidation {
+		out = append(out, "NOT VALID")
+	}
+
 	result := strings.Join(out, " ")
 	return &result, nil
 }
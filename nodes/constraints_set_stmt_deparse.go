@@ -2,6 +2,30 @@
 
 package pg_query
 
+import (
+	"strings"
+)
+
 func (node ConstraintsSetStmt) Deparse(ctx Context) (*string, error) {
-	panic("Not Implemented")
+	out := []string{"SET CONSTRAINTS"}
+
+	// Without any constraints the mode is set for all deferrable constraints.
+	if node.Constraints.Items != nil && len(node.Constraints.Items) > 0 {
+		if constraints, err := node.Constraints.DeparseList(Context_None); err != nil {
+			return nil, err
+		} else {
+			out = append(out, strings.Join(constraints, ", "))
+		}
+	} else {
+		out = append(out, "ALL")
+	}
+
+	if node.Deferred {
+		out = append(out, "DEFERRED")
+	} else {
+		out = append(out, "IMMEDIATE")
+	}
+
+	result := strings.Join(out, " ")
+	return &result, nil
 }
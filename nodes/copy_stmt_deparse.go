@@ -2,6 +2,65 @@
 
 package pg_query
 
+import (
+	"fmt"
+	"github.com/juju/errors"
+	"strings"
+)
+
 func (node CopyStmt) Deparse(ctx Context) (*string, error) {
-	panic("Not Implemented")
+	out := []string{"COPY"}
+
+	if node.Relation != nil {
+		if str, err := deparseNode(*node.Relation, Context_None); err != nil {
+			return nil, err
+		} else {
+			out = append(out, *str)
+		}
+
+		if node.Attlist.Items != nil && len(node.Attlist.Items) > 0 {
+			if cols, err := node.Attlist.DeparseList(Context_None); err != nil {
+				return nil, err
+			} else {
+				out = append(out, fmt.Sprintf("(%s)", strings.Join(cols, ", ")))
+			}
+		}
+	} else if node.Query != nil {
+		if str, err := deparseNode(node.Query, Context_None); err != nil {
+			return nil, err
+		} else {
+			out = append(out, fmt.Sprintf("(%s)", *str))
+		}
+	} else {
+		return nil, errors.New("copy statement must have either a relation or a query")
+	}
+
+	if node.IsFrom {
+		out = append(out, "FROM")
+	} else {
+		out = append(out, "TO")
+	}
+
+	if node.IsProgram {
+		out = append(out, "PROGRAM")
+	}
+
+	if node.Filename != nil {
+		out = append(out, quoteLiteral(*node.Filename))
+	} else if node.IsFrom {
+		out = append(out, "STDIN")
+	} else {
+		out = append(out, "STDOUT")
+	}
+
+	if node.Options.Items != nil && len(node.Options.Items) > 0 {
+		if options, err := node.Options.DeparseList(Context_None); err != nil {
+			return nil, err
+		} else {
+			out = append(out, fmt.Sprintf("WITH (%s)", strings.Join(options, ", ")))
+		}
+	}
+
+	result := strings.Join(out, " ")
+	return &result, nil
 }
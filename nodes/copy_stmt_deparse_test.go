@@ -0,0 +1,65 @@
+/*
+ * Copyright (c) 2019 Ready Stock
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+package pg_query
+
+import (
+	"testing"
+)
+
+func Test_CopyStmt_FromStdin(t *testing.T) {
+	DoTest(t, DeparseTest{
+		Query:    `COPY users FROM STDIN;`,
+		Expected: `COPY "users" FROM STDIN`,
+	})
+	DoTest(t, DeparseTest{
+		Query:    `COPY public.users (id, email) FROM STDIN WITH (FORMAT csv, HEADER);`,
+		Expected: `COPY "public"."users" ("id", "email") FROM STDIN WITH (format 'csv', header)`,
+	})
+}
+
+func Test_CopyStmt_LegacyOptions(t *testing.T) {
+	DoTest(t, DeparseTest{
+		Query:    `COPY users FROM STDIN WITH CSV HEADER DELIMITER ';' FORCE NOT NULL email;`,
+		Expected: `COPY "users" FROM STDIN WITH (format 'csv', header 1, delimiter ';', force_not_null ('email'))`,
+	})
+	DoTest(t, DeparseTest{
+		Query:    `COPY users TO STDOUT CSV FORCE QUOTE *;`,
+		Expected: `COPY "users" TO STDOUT WITH (format 'csv', force_quote *)`,
+	})
+	DoTest(t, DeparseTest{
+		Query:    `COPY BINARY users TO STDOUT;`,
+		Expected: `COPY "users" TO STDOUT WITH (format 'binary')`,
+	})
+}
+
+func Test_CopyStmt_File(t *testing.T) {
+	DoTest(t, DeparseTest{
+		Query:    `COPY users FROM '/tmp/users''s.csv' (FORMAT csv);`,
+		Expected: `COPY "users" FROM '/tmp/users''s.csv' WITH (format 'csv')`,
+	})
+	DoTest(t, DeparseTest{
+		Query:    `COPY users TO PROGRAM 'gzip > /tmp/users.csv.gz';`,
+		Expected: `COPY "users" TO PROGRAM 'gzip > /tmp/users.csv.gz'`,
+	})
+}
+
+func Test_CopyStmt_Query(t *testing.T) {
+	DoTest(t, DeparseTest{
+		Query:    `COPY (SELECT id, email FROM users WHERE id = 1) TO STDOUT WITH (FORMAT csv);`,
+		Expected: `COPY (SELECT "id", "email" FROM "users" WHERE "id" = 1) TO STDOUT WITH (format 'csv')`,
+	})
+}
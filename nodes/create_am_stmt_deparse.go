@@ -2,6 +2,37 @@
 
 package pg_query
 
+import (
+	"strings"
+
+	"github.com/juju/errors"
+)
+
+var (
+	accessMethodTypes = map[byte]string{
+		'i': "INDEX",
+	}
+)
+
 func (node CreateAmStmt) Deparse(ctx Context) (*string, error) {
-	panic("Not Implemented")
+	out := []string{"CREATE ACCESS METHOD"}
+	if node.Amname == nil {
+		return nil, errors.New("access method name cannot be null in create access method")
+	}
+	out = append(out, *node.Amname)
+
+	if amtype, ok := accessMethodTypes[node.Amtype]; !ok {
+		return nil, errors.Errorf("cannot deparse access method type (%c)", node.Amtype)
+	} else {
+		out = append(out, "TYPE", amtype)
+	}
+
+	if name, err := node.HandlerName.DeparseList(Context_FuncCall); err != nil {
+		return nil, err
+	} else {
+		out = append(out, "HANDLER", strings.Join(name, "."))
+	}
+
+	result := strings.Join(out, " ")
+	return &result, nil
 }
@@ -0,0 +1,77 @@
+/*
+ * Copyright (c) 2019 Ready Stock
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+package pg_query
+
+import (
+	"testing"
+)
+
+func Test_CreateConversion(t *testing.T) {
+	DoTest(t, DeparseTest{
+		Query:    `CREATE DEFAULT CONVERSION public.mydef FOR 'LATIN1' TO 'UTF8' FROM iso8859_1_to_utf8;`,
+		Expected: `CREATE DEFAULT CONVERSION "public"."mydef" FOR 'LATIN1' TO 'UTF8' FROM iso8859_1_to_utf8`,
+	})
+}
+
+func Test_CreateAm(t *testing.T) {
+	DoTest(t, DeparseTest{
+		Query:    `CREATE ACCESS METHOD gist2 TYPE INDEX HANDLER gisthandler;`,
+		Expected: `CREATE ACCESS METHOD gist2 TYPE INDEX HANDLER gisthandler`,
+	})
+}
+
+func Test_CreateTransform(t *testing.T) {
+	DoTest(t, DeparseTest{
+		Query:    `CREATE OR REPLACE TRANSFORM FOR hstore LANGUAGE plperl (FROM SQL WITH FUNCTION hstore_to_plperl(internal), TO SQL WITH FUNCTION plperl_to_hstore(internal));`,
+		Expected: `CREATE OR REPLACE TRANSFORM FOR hstore LANGUAGE plperl (FROM SQL WITH FUNCTION hstore_to_plperl(internal), TO SQL WITH FUNCTION plperl_to_hstore(internal))`,
+	})
+}
+
+func Test_CreateTransform_FromOnly(t *testing.T) {
+	DoTest(t, DeparseTest{
+		Query:    `CREATE TRANSFORM FOR int LANGUAGE sql (FROM SQL WITH FUNCTION f(internal));`,
+		Expected: `CREATE TRANSFORM FOR int LANGUAGE sql (FROM SQL WITH FUNCTION f(internal))`,
+	})
+}
+
+func Test_CreatePLang(t *testing.T) {
+	DoTest(t, DeparseTest{
+		Query:    `CREATE OR REPLACE TRUSTED PROCEDURAL LANGUAGE plsample HANDLER plsample_call_handler INLINE plsample_inline VALIDATOR plsample_validator;`,
+		Expected: `CREATE OR REPLACE TRUSTED LANGUAGE plsample HANDLER plsample_call_handler INLINE plsample_inline VALIDATOR plsample_validator`,
+	})
+}
+
+func Test_CreatePLang_Template(t *testing.T) {
+	DoTest(t, DeparseTest{
+		Query:    `CREATE LANGUAGE plpgsql;`,
+		Expected: `CREATE LANGUAGE plpgsql`,
+	})
+}
+
+func Test_CreateStats(t *testing.T) {
+	DoTest(t, DeparseTest{
+		Query:    `CREATE STATISTICS IF NOT EXISTS public.ab1_a_b_stats (ndistinct, dependencies) ON a, b FROM ab1;`,
+		Expected: `CREATE STATISTICS IF NOT EXISTS "public"."ab1_a_b_stats" (ndistinct, dependencies) ON "a", "b" FROM "ab1"`,
+	})
+}
+
+func Test_CreateStats_AllKinds(t *testing.T) {
+	DoTest(t, DeparseTest{
+		Query:    `CREATE STATISTICS s1 ON a, b FROM ab1;`,
+		Expected: `CREATE STATISTICS "s1" ON "a", "b" FROM "ab1"`,
+	})
+}
@@ -2,6 +2,56 @@
 
 package pg_query
 
+import (
+	"fmt"
+	"strings"
+
+	"github.com/juju/errors"
+)
+
+var (
+	castContexts = map[CoercionContext]string{
+		COERCION_IMPLICIT:   "AS IMPLICIT",
+		COERCION_ASSIGNMENT: "AS ASSIGNMENT",
+	}
+)
+
 func (node CreateCastStmt) Deparse(ctx Context) (*string, error) {
-	panic("Not Implemented")
+	if node.Sourcetype == nil || node.Targettype == nil {
+		return nil, errors.New("source and target type cannot be null in create cast")
+	}
+
+	out := []string{"CREATE CAST"}
+
+	source, err := deparseNode(*node.Sourcetype, Context_None)
+	if err != nil {
+		return nil, err
+	}
+
+	target, err := deparseNode(*node.Targettype, Context_None)
+	if err != nil {
+		return nil, err
+	}
+
+	out = append(out, fmt.Sprintf("(%s AS %s)", *source, *target))
+
+	if node.Func != nil {
+		if str, err := deparseNode(*node.Func, Context_None); err != nil {
+			return nil, err
+		} else {
+			out = append(out, "WITH FUNCTION", *str)
+		}
+	} else if node.Inout {
+		out = append(out, "WITH INOUT")
+	} else {
+		out = append(out, "WITHOUT FUNCTION")
+	}
+
+	// Explicit casts are the default so they do not need to be included.
+	if context, ok := castContexts[node.Context]; ok {
+		out = append(out, context)
+	}
+
+	result := strings.Join(out, " ")
+	return &result, nil
 }
@@ -2,6 +2,36 @@
 
 package pg_query
 
+import (
+	"strings"
+
+	"github.com/juju/errors"
+)
+
 func (node CreateConversionStmt) Deparse(ctx Context) (*string, error) {
-	panic("Not Implemented")
+	out := []string{"CREATE"}
+	if node.Def {
+		out = append(out, "DEFAULT")
+	}
+	out = append(out, "CONVERSION")
+
+	if name, err := node.ConversionName.DeparseList(Context_None); err != nil {
+		return nil, err
+	} else {
+		out = append(out, strings.Join(name, "."))
+	}
+
+	if node.ForEncodingName == nil || node.ToEncodingName == nil {
+		return nil, errors.New("encoding names cannot be null in create conversion")
+	}
+	out = append(out, "FOR", quoteLiteral(*node.ForEncodingName), "TO", quoteLiteral(*node.ToEncodingName))
+
+	if name, err := node.FuncName.DeparseList(Context_FuncCall); err != nil {
+		return nil, err
+	} else {
+		out = append(out, "FROM", strings.Join(name, "."))
+	}
+
+	result := strings.Join(out, " ")
+	return &result, nil
 }
@@ -2,6 +2,47 @@
 
 package pg_query
 
+import (
+	"strings"
+
+	"github.com/juju/errors"
+)
+
 func (node CreateDomainStmt) Deparse(ctx Context) (*string, error) {
-	panic("Not Implemented")
+	if node.TypeName == nil {
+		return nil, errors.New("type cannot be null in create domain")
+	}
+
+	out := []string{"CREATE DOMAIN"}
+	if names, err := node.Domainname.DeparseList(Context_None); err != nil {
+		return nil, err
+	} else {
+		out = append(out, strings.Join(names, "."))
+	}
+
+	out = append(out, "AS")
+	if str, err := deparseNode(*node.TypeName, Context_None); err != nil {
+		return nil, err
+	} else {
+		out = append(out, *str)
+	}
+
+	if node.CollClause != nil {
+		if str, err := deparseNode(*node.CollClause, Context_None); err != nil {
+			return nil, err
+		} else {
+			out = append(out, *str)
+		}
+	}
+
+	if node.Constraints.Items != nil && len(node.Constraints.Items) > 0 {
+		if constraints, err := node.Constraints.DeparseList(Context_None); err != nil {
+			return nil, err
+		} else {
+			out = append(out, constraints...)
+		}
+	}
+
+	result := strings.Join(out, " ")
+	return &result, nil
 }
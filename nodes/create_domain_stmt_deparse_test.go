@@ -0,0 +1,84 @@
+/*
+ * Copyright (c) 2019 Ready Stock
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+package pg_query
+
+import (
+	"testing"
+)
+
+func Test_CreateDomainStmt(t *testing.T) {
+	DoTest(t, DeparseTest{
+		Query:    `CREATE DOMAIN dcheck varchar(15) COLLATE "C" DEFAULT 'x' CONSTRAINT dcheck_nn NOT NULL CHECK (VALUE = 'a' OR VALUE = 'c');`,
+		Expected: `CREATE DOMAIN "dcheck" AS varchar(15) COLLATE "C" DEFAULT 'x' CONSTRAINT dcheck_nn NOT NULL CHECK ("value" = 'a' OR "value" = 'c')`,
+	})
+}
+
+func Test_AlterDomainStmt_SetDefault(t *testing.T) {
+	DoTest(t, DeparseTest{
+		Query:    `ALTER DOMAIN dom SET DEFAULT 5;`,
+		Expected: `ALTER DOMAIN "dom" SET DEFAULT 5`,
+	})
+}
+
+func Test_AlterDomainStmt_DropDefault(t *testing.T) {
+	DoTest(t, DeparseTest{
+		Query:    `ALTER DOMAIN dom DROP DEFAULT;`,
+		Expected: `ALTER DOMAIN "dom" DROP DEFAULT`,
+	})
+}
+
+func Test_AlterDomainStmt_NotNull(t *testing.T) {
+	DoTest(t, DeparseTest{
+		Query:    `ALTER DOMAIN dom SET NOT NULL;`,
+		Expected: `ALTER DOMAIN "dom" SET NOT NULL`,
+	})
+}
+
+func Test_AlterDomainStmt_AddConstraint(t *testing.T) {
+	DoTest(t, DeparseTest{
+		Query:    `ALTER DOMAIN con ADD CONSTRAINT t CHECK (VALUE < 34) NOT VALID;`,
+		Expected: `ALTER DOMAIN "con" ADD CONSTRAINT t CHECK ("value" < 34) NOT VALID`,
+	})
+}
+
+func Test_AlterDomainStmt_DropConstraint(t *testing.T) {
+	DoTest(t, DeparseTest{
+		Query:    `ALTER DOMAIN con DROP CONSTRAINT IF EXISTS nonexistent CASCADE;`,
+		Expected: `ALTER DOMAIN "con" DROP CONSTRAINT IF EXISTS nonexistent CASCADE`,
+	})
+}
+
+func Test_AlterDomainStmt_ValidateConstraint(t *testing.T) {
+	DoTest(t, DeparseTest{
+		Query:    `ALTER DOMAIN things VALIDATE CONSTRAINT meow;`,
+		Expected: `ALTER DOMAIN "things" VALIDATE CONSTRAINT meow`,
+	})
+}
+
+func Test_AlterDomainStmt_QuotedConstraint(t *testing.T) {
+	DoTest(t, DeparseTest{
+		Query:    `ALTER DOMAIN d DROP CONSTRAINT IF EXISTS "Chk"`,
+		Expected: `ALTER DOMAIN "d" DROP CONSTRAINT IF EXISTS "Chk"`,
+	})
+}
+
+func Test_AlterDomainStmt_ValidateQuotedConstraint(t *testing.T) {
+	DoTest(t, DeparseTest{
+		Query:    `ALTER DOMAIN d VALIDATE CONSTRAINT "check"`,
+		Expected: `ALTER DOMAIN "d" VALIDATE CONSTRAINT "check"`,
+	})
+}
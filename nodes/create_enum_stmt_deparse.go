@@ -2,6 +2,25 @@
 
 package pg_query
 
+import (
+	"fmt"
+	"strings"
+)
+
 func (node CreateEnumStmt) Deparse(ctx Context) (*string, error) {
-	panic("Not Implemented")
+	out := []string{"CREATE TYPE"}
+	if names, err := node.TypeName.DeparseList(Context_None); err != nil {
+		return nil, err
+	} else {
+		out = append(out, strings.Join(names, "."))
+	}
+
+	if values, err := node.Vals.DeparseList(Context_AConst); err != nil {
+		return nil, err
+	} else {
+		out = append(out, fmt.Sprintf("AS ENUM (%s)", strings.Join(values, ", ")))
+	}
+
+	result := strings.Join(out, " ")
+	return &result, nil
 }
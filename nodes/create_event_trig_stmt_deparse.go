@@ -2,6 +2,48 @@
 
 package pg_query
 
+import (
+	"fmt"
+	"strings"
+
+	"github.com/juju/errors"
+)
+
 func (node CreateEventTrigStmt) Deparse(ctx Context) (*string, error) {
-	panic("Not Implemented")
+	if node.Trigname == nil || node.Eventname == nil {
+		return nil, errors.New("event trigger must have a name and an event")
+	}
+
+	out := []string{"CREATE EVENT TRIGGER", quoteIdentifier(*node.Trigname), "ON", quoteIdentifier(*node.Eventname)}
+
+	if node.Whenclause.Items != nil && len(node.Whenclause.Items) > 0 {
+		filters := make([]string, len(node.Whenclause.Items))
+		for i, item := range node.Whenclause.Items {
+			filter, ok := item.(DefElem)
+			if !ok || filter.Defname == nil {
+				return nil, errors.New("event trigger filters must be named definitions")
+			}
+
+			values, ok := filter.Arg.(List)
+			if !ok {
+				return nil, errors.New("event trigger filter values must be a list")
+			}
+
+			if items, err := values.DeparseList(Context_AConst); err != nil {
+				return nil, err
+			} else {
+				filters[i] = fmt.Sprintf("%s IN (%s)", quoteIdentifier(*filter.Defname), strings.Join(items, ", "))
+			}
+		}
+		out = append(out, "WHEN", strings.Join(filters, " AND "))
+	}
+
+	if str, err := deparseTriggerFunction(node.Funcname, List{}); err != nil {
+		return nil, err
+	} else {
+		out = append(out, *str)
+	}
+
+	result := strings.Join(out, " ")
+	return &result, nil
 }
@@ -2,6 +2,66 @@
 
 package pg_query
 
+import (
+	"strings"
+
+	"github.com/juju/errors"
+)
+
 func (node CreateExtensionStmt) Deparse(ctx Context) (*string, error) {
-	panic("Not Implemented")
+	out := []string{"CREATE EXTENSION"}
+	if node.IfNotExists {
+		out = append(out, "IF NOT EXISTS")
+	}
+
+	if node.Extname == nil {
+		return nil, errors.New("extension name cannot be null in create extension")
+	}
+	out = append(out, quoteIdentifier(*node.Extname))
+
+	if node.Options.Items != nil && len(node.Options.Items) > 0 {
+		if options, err := deparseExtensionOptions(node.Options); err != nil {
+			return nil, err
+		} else {
+			out = append(out, "WITH")
+			out = append(out, options...)
+		}
+	}
+
+	result := strings.Join(out, " ")
+	return &result, nil
+}
+
+func deparseExtensionOptions(options List) ([]string, error) {
+	out := make([]string, 0)
+	for _, item := range options.Items {
+		option, ok := item.(DefElem)
+		if !ok || option.Defname == nil {
+			return nil, errors.Errorf("cannot deparse extension option of type %T", item)
+		}
+
+		switch *option.Defname {
+		case "schema":
+			if str, err := deparseNode(option.Arg, Context_FuncCall); err != nil {
+				return nil, err
+			} else {
+				out = append(out, "SCHEMA", *str)
+			}
+		case "new_version", "old_version":
+			if str, err := option.deparseArg(); err != nil {
+				return nil, err
+			} else if *option.Defname == "new_version" {
+				out = append(out, "VERSION", *str)
+			} else {
+				out = append(out, "FROM", *str)
+			}
+		case "cascade":
+			if value, ok := option.Arg.(Integer); !ok || value.Ival != 0 {
+				out = append(out, "CASCADE")
+			}
+		default:
+			return nil, errors.Errorf("cannot deparse extension option [%s]", *option.Defname)
+		}
+	}
+	return out, nil
 }
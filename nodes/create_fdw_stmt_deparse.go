@@ -2,6 +2,58 @@
 
 package pg_query
 
+import (
+	"fmt"
+	"strings"
+
+	"github.com/juju/errors"
+)
+
 func (node CreateFdwStmt) Deparse(ctx Context) (*string, error) {
-	panic("Not Implemented")
+	if node.Fdwname == nil {
+		return nil, errors.New("foreign data wrapper name cannot be null in create foreign data wrapper")
+	}
+
+	out := []string{"CREATE FOREIGN DATA WRAPPER", quoteIdentifier(*node.Fdwname)}
+
+	if options, err := deparseFdwFuncOptions(node.FuncOptions); err != nil {
+		return nil, err
+	} else {
+		out = append(out, options...)
+	}
+
+	if node.Options.Items != nil && len(node.Options.Items) > 0 {
+		if str, err := deparseGenericOptions(node.Options); err != nil {
+			return nil, err
+		} else {
+			out = append(out, *str)
+		}
+	}
+
+	result := strings.Join(out, " ")
+	return &result, nil
+}
+
+// deparseFdwFuncOptions returns the HANDLER and VALIDATOR functions of a
+// foreign-data wrapper, an option without a function removes it.
+func deparseFdwFuncOptions(options List) ([]string, error) {
+	out := make([]string, len(options.Items))
+	for i, item := range options.Items {
+		option, ok := item.(DefElem)
+		if !ok || option.Defname == nil {
+			return nil, errors.Errorf("cannot deparse foreign-data wrapper option of type %T", item)
+		}
+
+		keyword := strings.ToUpper(*option.Defname)
+		if option.Arg == nil {
+			out[i] = fmt.Sprintf("NO %s", keyword)
+		} else if names, ok := option.Arg.(List); !ok {
+			return nil, errors.Errorf("foreign-data wrapper %s must be a function name", keyword)
+		} else if names, err := names.DeparseList(Context_None); err != nil {
+			return nil, err
+		} else {
+			out[i] = fmt.Sprintf("%s %s", keyword, strings.Join(names, "."))
+		}
+	}
+	return out, nil
 }
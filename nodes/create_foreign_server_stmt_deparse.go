@@ -5,41 +5,41 @@ package pg_query
 import (
 	"fmt"
 	"strings"
+
+	"github.com/juju/errors"
 )
 
 func (node CreateForeignServerStmt) Deparse(ctx Context) (*string, error) {
-	out := []string{"CREATE SERVER", ""}
+	out := []string{"CREATE SERVER"}
+	if node.IfNotExists {
+		out = append(out, "IF NOT EXISTS")
+	}
 
 	if node.Servername == nil {
-		panic("server name cannot be nil in create server")
+		return nil, errors.New("server name cannot be null in create server")
 	}
-	out[1] = *node.Servername
+	out = append(out, quoteIdentifier(*node.Servername))
 
 	if node.Servertype != nil {
-		out = append(out, fmt.Sprintf("TYPE '%s'", *node.Servertype))
+		out = append(out, fmt.Sprintf("TYPE %s", quoteLiteral(*node.Servertype)))
 	}
 
 	if node.Version != nil {
-		out = append(out, fmt.Sprintf("VERSION '%s'", *node.Version))
+		out = append(out, fmt.Sprintf("VERSION %s", quoteLiteral(*node.Version)))
 	}
 
 	if node.Fdwname == nil {
-		panic("fdwname cannot be nil in create server")
+		return nil, errors.New("foreign data wrapper name cannot be null in create server")
 	}
 
-	out = append(out, fmt.Sprintf("FOREIGN DATA WRAPPER %s", *node.Fdwname))
+	out = append(out, fmt.Sprintf("FOREIGN DATA WRAPPER %s", quoteIdentifier(*node.Fdwname)))
 
 	if node.Options.Items != nil && len(node.Options.Items) > 0 {
-		out = append(out, "OPTIONS")
-		options := make([]string, len(node.Options.Items))
-		for i, option := range node.Options.Items {
-			if str, err := option.Deparse(Context_None); err != nil {
-				return nil, err
-			} else {
-				options[i] = *str
-			}
+		if str, err := deparseGenericOptions(node.Options); err != nil {
+			return nil, err
+		} else {
+			out = append(out, *str)
 		}
-		out = append(out, fmt.Sprintf("(%s)", strings.Join(options, ", ")))
 	}
 
 	result := strings.Join(out, " ")
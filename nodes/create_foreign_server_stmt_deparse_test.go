@@ -18,3 +18,122 @@ func Test_CreateForeignServerStmt_Generic(t *testing.T) {
 		Expected: `CREATE SERVER test TYPE 'type' VERSION '123' FOREIGN DATA WRAPPER postgres_fdw OPTIONS (host 'foo', dbname 'foodb', port '5432')`,
 	})
 }
+
+func Test_CreateForeignServerStmt_IfNotExists(t *testing.T) {
+	DoTest(t, DeparseTest{
+		Query:    `CREATE SERVER IF NOT EXISTS s1 FOREIGN DATA WRAPPER foo;`,
+		Expected: `CREATE SERVER IF NOT EXISTS s1 FOREIGN DATA WRAPPER foo`,
+	})
+}
+
+func Test_AlterForeignServerStmt(t *testing.T) {
+	DoTest(t, DeparseTest{
+		Query:    `ALTER SERVER s3 VERSION '1.1' OPTIONS (SET tns_name 'orcl', ADD dbname 'x', DROP host);`,
+		Expected: `ALTER SERVER s3 VERSION '1.1' OPTIONS (SET tns_name 'orcl', ADD dbname 'x', DROP host)`,
+	})
+}
+
+func Test_AlterForeignServerStmt_NullVersion(t *testing.T) {
+	DoTest(t, DeparseTest{
+		Query:    `ALTER SERVER s1 VERSION NULL;`,
+		Expected: `ALTER SERVER s1 VERSION NULL`,
+	})
+}
+
+func Test_CreateFdwStmt(t *testing.T) {
+	DoTest(t, DeparseTest{
+		Query:    `CREATE FOREIGN DATA WRAPPER foo HANDLER test_fdw_handler VALIDATOR postgresql_fdw_validator OPTIONS (testing '1', another '2');`,
+		Expected: `CREATE FOREIGN DATA WRAPPER foo HANDLER "test_fdw_handler" VALIDATOR "postgresql_fdw_validator" OPTIONS (testing '1', another '2')`,
+	})
+}
+
+func Test_AlterFdwStmt(t *testing.T) {
+	DoTest(t, DeparseTest{
+		Query:    `ALTER FOREIGN DATA WRAPPER foo NO VALIDATOR OPTIONS (ADD d '5', SET c '4', DROP a);`,
+		Expected: `ALTER FOREIGN DATA WRAPPER foo NO VALIDATOR OPTIONS (ADD d '5', SET c '4', DROP a)`,
+	})
+}
+
+func Test_CreateForeignTableStmt(t *testing.T) {
+	DoTest(t, DeparseTest{
+		Query:    `CREATE FOREIGN TABLE ft1 (c1 integer OPTIONS (param1 'val1') NOT NULL, c2 text OPTIONS (param2 'val2', param3 'val3'), c3 date) SERVER s0 OPTIONS (delimiter ',', quote '"');`,
+		Expected: `CREATE FOREIGN TABLE "ft1" (c1 int OPTIONS (param1 'val1') NOT NULL, c2 text OPTIONS (param2 'val2', param3 'val3'), c3 date) SERVER s0 OPTIONS (delimiter ',', quote '"')`,
+	})
+}
+
+func Test_CreateForeignTableStmt_Partition(t *testing.T) {
+	DoTest(t, DeparseTest{
+		Query:    `CREATE FOREIGN TABLE ft2 PARTITION OF pt1 FOR VALUES IN (1) SERVER s0 OPTIONS (delimiter ',');`,
+		Expected: `CREATE FOREIGN TABLE "ft2" PARTITION OF "pt1" FOR VALUES IN (1) SERVER s0 OPTIONS (delimiter ',')`,
+	})
+}
+
+func Test_CreateUserMappingStmt(t *testing.T) {
+	DoTest(t, DeparseTest{
+		Query:    `CREATE USER MAPPING IF NOT EXISTS FOR current_user SERVER s4 OPTIONS (user 'admin', password 'secret');`,
+		Expected: `CREATE USER MAPPING IF NOT EXISTS FOR CURRENT_USER SERVER s4 OPTIONS (user 'admin', password 'secret')`,
+	})
+}
+
+func Test_AlterUserMappingStmt(t *testing.T) {
+	DoTest(t, DeparseTest{
+		Query:    `ALTER USER MAPPING FOR PUBLIC SERVER s4 OPTIONS (DROP password);`,
+		Expected: `ALTER USER MAPPING FOR PUBLIC SERVER s4 OPTIONS (DROP password)`,
+	})
+}
+
+func Test_DropUserMappingStmt(t *testing.T) {
+	DoTest(t, DeparseTest{
+		Query:    `DROP USER MAPPING IF EXISTS FOR regress_test_role SERVER s4;`,
+		Expected: `DROP USER MAPPING IF EXISTS FOR regress_test_role SERVER s4`,
+	})
+}
+
+func Test_ImportForeignSchemaStmt(t *testing.T) {
+	DoTest(t, DeparseTest{
+		Query:    `IMPORT FOREIGN SCHEMA s1 FROM SERVER s9 INTO public OPTIONS (option1 'value1');`,
+		Expected: `IMPORT FOREIGN SCHEMA s1 FROM SERVER s9 INTO public OPTIONS (option1 'value1')`,
+	})
+}
+
+func Test_ImportForeignSchemaStmt_LimitTo(t *testing.T) {
+	DoTest(t, DeparseTest{
+		Query:    `IMPORT FOREIGN SCHEMA s1 LIMIT TO (t1, t2) FROM SERVER s9 INTO public;`,
+		Expected: `IMPORT FOREIGN SCHEMA s1 LIMIT TO (t1, t2) FROM SERVER s9 INTO public`,
+	})
+}
+
+func Test_ImportForeignSchemaStmt_Except(t *testing.T) {
+	DoTest(t, DeparseTest{
+		Query:    `IMPORT FOREIGN SCHEMA s1 EXCEPT (t1) FROM SERVER s9 INTO s2;`,
+		Expected: `IMPORT FOREIGN SCHEMA s1 EXCEPT (t1) FROM SERVER s9 INTO s2`,
+	})
+}
+
+func Test_CreateForeignServerStmt_QuotedNames(t *testing.T) {
+	DoTest(t, DeparseTest{
+		Query:    `CREATE SERVER "Srv" FOREIGN DATA WRAPPER "Fdw" OPTIONS ("tns name" 'x', "Host" 'foo')`,
+		Expected: `CREATE SERVER "Srv" FOREIGN DATA WRAPPER "Fdw" OPTIONS ("tns name" 'x', "Host" 'foo')`,
+	})
+}
+
+func Test_AlterForeignServerStmt_QuotedOptions(t *testing.T) {
+	DoTest(t, DeparseTest{
+		Query:    `ALTER SERVER "Srv" OPTIONS (SET "tns name" 'y', DROP "Host")`,
+		Expected: `ALTER SERVER "Srv" OPTIONS (SET "tns name" 'y', DROP "Host")`,
+	})
+}
+
+func Test_CreateUserMappingStmt_QuotedServer(t *testing.T) {
+	DoTest(t, DeparseTest{
+		Query:    `CREATE USER MAPPING FOR "Bob" SERVER "Srv" OPTIONS (user 'bob')`,
+		Expected: `CREATE USER MAPPING FOR "Bob" SERVER "Srv" OPTIONS (user 'bob')`,
+	})
+}
+
+func Test_ImportForeignSchemaStmt_QuotedNames(t *testing.T) {
+	DoTest(t, DeparseTest{
+		Query:    `IMPORT FOREIGN SCHEMA "Remote" FROM SERVER "Srv" INTO "Local"`,
+		Expected: `IMPORT FOREIGN SCHEMA "Remote" FROM SERVER "Srv" INTO "Local"`,
+	})
+}
@@ -2,6 +2,34 @@
 
 package pg_query
 
+import (
+	"strings"
+
+	"github.com/juju/errors"
+)
+
 func (node CreateForeignTableStmt) Deparse(ctx Context) (*string, error) {
-	panic("Not Implemented")
+	if node.Servername == nil {
+		return nil, errors.New("server name cannot be null in create foreign table")
+	}
+
+	out := []string{"CREATE FOREIGN TABLE"}
+	if str, err := node.Base.deparseTable(); err != nil {
+		return nil, err
+	} else {
+		out = append(out, *str)
+	}
+
+	out = append(out, "SERVER", quoteIdentifier(*node.Servername))
+
+	if node.Options.Items != nil && len(node.Options.Items) > 0 {
+		if str, err := deparseGenericOptions(node.Options); err != nil {
+			return nil, err
+		} else {
+			out = append(out, *str)
+		}
+	}
+
+	result := strings.Join(out, " ")
+	return &result, nil
 }
@@ -2,6 +2,192 @@
 
 package pg_query
 
+import (
+	"fmt"
+	"github.com/juju/errors"
+	"strings"
+)
+
 func (node CreateFunctionStmt) Deparse(ctx Context) (*string, error) {
-	panic("Not Implemented")
+	out := []string{"CREATE"}
+	if node.Replace {
+		out = append(out, "OR REPLACE")
+	}
+	out = append(out, "FUNCTION")
+
+	name, err := node.Funcname.DeparseList(Context_FuncCall)
+	if err != nil {
+		return nil, err
+	}
+
+	// Columns of RETURNS TABLE are stored as parameters too, they need to be
+	// split out of the regular parameters again.
+	params, columns := make([]string, 0), make([]string, 0)
+	for _, item := range node.Parameters.Items {
+		param, ok := item.(FunctionParameter)
+		if !ok {
+			return nil, errors.Errorf("invalid function parameter: %T", item)
+		}
+		if str, err := deparseNode(param, Context_None); err != nil {
+			return nil, err
+		} else if param.Mode == FUNC_PARAM_TABLE {
+			columns = append(columns, *str)
+		} else {
+			params = append(params, *str)
+		}
+	}
+	out = append(out, fmt.Sprintf("%s(%s)", strings.Join(name, "."), strings.Join(params, ", ")))
+
+	if len(columns) > 0 {
+		out = append(out, fmt.Sprintf("RETURNS TABLE (%s)", strings.Join(columns, ", ")))
+	} else if node.ReturnType != nil {
+		if str, err := deparseNode(*node.ReturnType, Context_None); err != nil {
+			return nil, err
+		} else {
+			out = append(out, "RETURNS", *str)
+		}
+	}
+
+	for _, item := range node.Options.Items {
+		option, ok := item.(DefElem)
+		if !ok {
+			return nil, errors.Errorf("invalid function option: %T", item)
+		}
+		if str, err := deparseFunctionOption(option); err != nil {
+			return nil, err
+		} else {
+			out = append(out, *str)
+		}
+	}
+
+	if node.WithClause.Items != nil && len(node.WithClause.Items) > 0 {
+		if options, err := node.WithClause.DeparseList(Context_None); err != nil {
+			return nil, err
+		} else {
+			out = append(out, fmt.Sprintf("WITH (%s)", strings.Join(options, ", ")))
+		}
+	}
+
+	result := strings.Join(out, " ")
+	return &result, nil
+}
+
+// deparseFunctionOption deparses one of the options that can be provided when
+// creating or altering a function.
+func deparseFunctionOption(option DefElem) (*string, error) {
+	if option.Defname == nil {
+		return nil, errors.New("function option must have a name")
+	}
+
+	result := ""
+	switch *option.Defname {
+	case "as":
+		definition, ok := option.Arg.(List)
+		if !ok {
+			return nil, errors.Errorf("invalid function definition: %T", option.Arg)
+		}
+		parts := make([]string, len(definition.Items))
+		for i, item := range definition.Items {
+			part, ok := item.(String)
+			if !ok {
+				return nil, errors.Errorf("invalid function definition part: %T", item)
+			}
+			parts[i] = part.Str
+		}
+		if len(parts) == 1 {
+			result = fmt.Sprintf("AS %s", dollarQuote(parts[0]))
+		} else {
+			// C functions are defined by the object file and the link symbol.
+			for i, part := range parts {
+				parts[i] = quoteLiteral(part)
+			}
+			result = fmt.Sprintf("AS %s", strings.Join(parts, ", "))
+		}
+	case "language":
+		if language, err := functionOptionString(option); err != nil {
+			return nil, err
+		} else {
+			result = fmt.Sprintf("LANGUAGE %s", quoteIdentifier(language))
+		}
+	case "transform":
+		transforms, ok := option.Arg.(List)
+		if !ok {
+			return nil, errors.Errorf("invalid function transform: %T", option.Arg)
+		}
+		types := make([]string, len(transforms.Items))
+		for i, item := range transforms.Items {
+			if str, err := deparseNode(item, Context_None); err != nil {
+				return nil, err
+			} else {
+				types[i] = fmt.Sprintf("FOR TYPE %s", *str)
+			}
+		}
+		result = fmt.Sprintf("TRANSFORM %s", strings.Join(types, ", "))
+	case "window":
+		result = "WINDOW"
+	case "strict":
+		if enabled, err := functionOptionBool(option); err != nil {
+			return nil, err
+		} else if enabled {
+			result = "STRICT"
+		} else {
+			result = "CALLED ON NULL INPUT"
+		}
+	case "volatility":
+		if volatility, err := functionOptionString(option); err != nil {
+			return nil, err
+		} else {
+			result = strings.ToUpper(volatility)
+		}
+	case "security":
+		if enabled, err := functionOptionBool(option); err != nil {
+			return nil, err
+		} else if enabled {
+			result = "SECURITY DEFINER"
+		} else {
+			result = "SECURITY INVOKER"
+		}
+	case "leakproof":
+		if enabled, err := functionOptionBool(option); err != nil {
+			return nil, err
+		} else if enabled {
+			result = "LEAKPROOF"
+		} else {
+			result = "NOT LEAKPROOF"
+		}
+	case "cost", "rows":
+		if str, err := deparseNode(option.Arg, Context_None); err != nil {
+			return nil, err
+		} else {
+			result = fmt.Sprintf("%s %s", strings.ToUpper(*option.Defname), *str)
+		}
+	case "parallel":
+		if parallel, err := functionOptionString(option); err != nil {
+			return nil, err
+		} else {
+			result = fmt.Sprintf("PARALLEL %s", parallel)
+		}
+	case "set":
+		return deparseNode(option.Arg, Context_None)
+	default:
+		return nil, errors.Errorf("cannot deparse function option: %s", *option.Defname)
+	}
+	return &result, nil
+}
+
+// functionOptionString returns the string argument of a function option.
+func functionOptionString(option DefElem) (string, error) {
+	if arg, ok := option.Arg.(String); ok {
+		return arg.Str, nil
+	}
+	return "", errors.Errorf("invalid argument for function option %s: %T", *option.Defname, option.Arg)
+}
+
+// functionOptionBool returns the boolean argument of a function option, which
+// the parser stores as an integer.
+func functionOptionBool(option DefElem) (bool, error) {
+	if arg, ok := option.Arg.(Integer); ok {
+		return arg.Ival != 0, nil
+	}
+	return false, errors.Errorf("invalid argument for function option %s: %T", *option.Defname, option.Arg)
 }
@@ -0,0 +1,107 @@
+/*
+ * Copyright (c) 2019 Ready Stock
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+package pg_query
+
+import (
+	"testing"
+)
+
+func Test_CreateFunctionStmt_Simple(t *testing.T) {
+	DoTest(t, DeparseTest{
+		Query: `CREATE FUNCTION functest_A_1(text, date) RETURNS bool LANGUAGE 'sql'
+       AS 'SELECT $1 = ''abcd'' AND $2 > ''2001-01-01''';`,
+		Expected: `CREATE FUNCTION functest_a_1(text, date) RETURNS bool LANGUAGE sql AS $$SELECT $1 = 'abcd' AND $2 > '2001-01-01'$$`,
+	})
+	DoTest(t, DeparseTest{
+		Query:    `CREATE FUNCTION functest_A_2(text[]) RETURNS int LANGUAGE 'sql' AS 'SELECT $1[0]::int';`,
+		Expected: `CREATE FUNCTION functest_a_2(text[]) RETURNS int LANGUAGE sql AS $$SELECT $1[0]::int$$`,
+	})
+	DoTest(t, DeparseTest{
+		Query:    `CREATE OR REPLACE FUNCTION public.functest_A_3() RETURNS SETOF bool LANGUAGE sql AS $$SELECT false$$;`,
+		Expected: `CREATE OR REPLACE FUNCTION public.functest_a_3() RETURNS SETOF bool LANGUAGE sql AS $$SELECT false$$`,
+	})
+}
+
+func Test_CreateFunctionStmt_Parameters(t *testing.T) {
+	DoTest(t, DeparseTest{
+		Query:    `CREATE FUNCTION functest_is_1(a int, b int default 1, VARIADIC c int[]) RETURNS int LANGUAGE sql AS 'SELECT a + b';`,
+		Expected: `CREATE FUNCTION functest_is_1(a int, b int DEFAULT 1, VARIADIC c int[]) RETURNS int LANGUAGE sql AS $$SELECT a + b$$`,
+	})
+	DoTest(t, DeparseTest{
+		Query:    `CREATE FUNCTION dfunc(IN a int, INOUT b text, OUT c bigint) LANGUAGE sql AS 'SELECT $2, $1::bigint';`,
+		Expected: `CREATE FUNCTION dfunc(a int, INOUT b text, OUT c bigint) LANGUAGE sql AS $$SELECT $2, $1::bigint$$`,
+	})
+	DoTest(t, DeparseTest{
+		Query:    `CREATE FUNCTION functest_tf(x int) RETURNS TABLE (a int, b text) LANGUAGE sql AS 'SELECT x, ''y''';`,
+		Expected: `CREATE FUNCTION functest_tf(x int) RETURNS TABLE (a int, b text) LANGUAGE sql AS $$SELECT x, 'y'$$`,
+	})
+}
+
+func Test_CreateFunctionStmt_Options(t *testing.T) {
+	DoTest(t, DeparseTest{
+		Query:    `CREATE FUNCTION functext_C_2(int) RETURNS bool LANGUAGE 'sql' SECURITY DEFINER STRICT IMMUTABLE LEAKPROOF COST 10 SET search_path = public, pg_temp AS 'SELECT $1 = 0';`,
+		Expected: `CREATE FUNCTION functext_c_2(int) RETURNS bool LANGUAGE sql SECURITY DEFINER STRICT IMMUTABLE LEAKPROOF COST 10 SET search_path TO 'public', 'pg_temp' AS $$SELECT $1 = 0$$`,
+	})
+	DoTest(t, DeparseTest{
+		Query:    `CREATE FUNCTION functext_F_2(int) RETURNS SETOF int LANGUAGE 'sql' CALLED ON NULL INPUT SECURITY INVOKER ROWS 5 PARALLEL SAFE WINDOW AS 'SELECT $1';`,
+		Expected: `CREATE FUNCTION functext_f_2(int) RETURNS SETOF int LANGUAGE sql CALLED ON NULL INPUT SECURITY INVOKER ROWS 5 PARALLEL safe WINDOW AS $$SELECT $1$$`,
+	})
+	DoTest(t, DeparseTest{
+		Query:    `CREATE FUNCTION test_c(int) RETURNS int AS 'regresslib', 'test_c' LANGUAGE C WITH (isStrict);`,
+		Expected: `CREATE FUNCTION test_c(int) RETURNS int AS 'regresslib', 'test_c' LANGUAGE c WITH (isstrict)`,
+	})
+}
+
+func Test_CreateFunctionStmt_DollarQuoting(t *testing.T) {
+	DoTest(t, DeparseTest{
+		Query:    `CREATE FUNCTION quoted() RETURNS text LANGUAGE sql AS $q$SELECT $$a$$ || $body$b$body$$q$;`,
+		Expected: `CREATE FUNCTION quoted() RETURNS text LANGUAGE sql AS $body1$SELECT $$a$$ || $body$b$body$$body1$`,
+	})
+	DoTest(t, DeparseTest{
+		Query:    `CREATE FUNCTION trailing_dollar() RETURNS text LANGUAGE plpgsql AS 'BEGIN RETURN ''$''; END;$';`,
+		Expected: `CREATE FUNCTION trailing_dollar() RETURNS text LANGUAGE plpgsql AS $body$BEGIN RETURN '$'; END;$$body$`,
+	})
+}
+
+func Test_AlterFunctionStmt(t *testing.T) {
+	DoTest(t, DeparseTest{
+		Query:    `ALTER FUNCTION functest_B_2(int) VOLATILE;`,
+		Expected: `ALTER FUNCTION functest_b_2(int) VOLATILE`,
+	})
+	DoTest(t, DeparseTest{
+		Query:    `ALTER FUNCTION functext_E_2(int) NOT LEAKPROOF COST 100 RESET ALL;`,
+		Expected: `ALTER FUNCTION functext_e_2(int) NOT LEAKPROOF COST 100 RESET ALL`,
+	})
+	DoTest(t, DeparseTest{
+		Query:    `ALTER FUNCTION functext_C_3 SECURITY DEFINER SET work_mem FROM CURRENT RESET search_path;`,
+		Expected: `ALTER FUNCTION functext_c_3 SECURITY DEFINER SET work_mem FROM CURRENT RESET search_path`,
+	})
+}
+
+func Test_CreateFunctionStmt_QuotedNames(t *testing.T) {
+	DoTest(t, DeparseTest{
+		Query:    `CREATE FUNCTION "F"("Arg" int, "select" text) RETURNS int LANGUAGE "PlLang" AS $$SELECT 1$$`,
+		Expected: `CREATE FUNCTION "F"("Arg" int, "select" text) RETURNS int LANGUAGE "PlLang" AS $$SELECT 1$$`,
+	})
+}
+
+func Test_DoStmt_QuotedLanguage(t *testing.T) {
+	DoTest(t, DeparseTest{
+		Query:    `DO LANGUAGE "PlLang" $$BEGIN END$$`,
+		Expected: `DO LANGUAGE "PlLang" $$BEGIN END$$`,
+	})
+}
@@ -2,6 +2,74 @@
 
 package pg_query
 
+import (
+	"fmt"
+	"strings"
+
+	"github.com/juju/errors"
+)
+
 func (node CreateOpClassItem) Deparse(ctx Context) (*string, error) {
-	panic("Not Implemented")
+	out := make([]string, 0)
+	switch node.Itemtype {
+	case OPCLASS_ITEM_OPERATOR:
+		out = append(out, "OPERATOR", fmt.Sprintf("%d", node.Number))
+	case OPCLASS_ITEM_FUNCTION:
+		out = append(out, "FUNCTION", fmt.Sprintf("%d", node.Number))
+	case OPCLASS_ITEM_STORAGETYPE:
+		if node.Storedtype == nil {
+			return nil, errors.New("storage type cannot be null in operator class item")
+		}
+		if str, err := deparseNode(*node.Storedtype, Context_None); err != nil {
+			return nil, err
+		} else {
+			out = append(out, "STORAGE", *str)
+		}
+		result := strings.Join(out, " ")
+		return &result, nil
+	default:
+		return nil, errors.Errorf("cannot deparse operator class item type (%d)", node.Itemtype)
+	}
+
+	// The types a support function is registered for are written before the
+	// function itself, when dropping an item they are all that is specified.
+	if node.ClassArgs.Items != nil && len(node.ClassArgs.Items) > 0 {
+		if args, err := node.ClassArgs.DeparseList(Context_None); err != nil {
+			return nil, err
+		} else {
+			out = append(out, fmt.Sprintf("(%s)", strings.Join(args, ", ")))
+		}
+	}
+
+	// Operators may be given without their argument types, in which case they
+	// are taken from the operator class instead.
+	if node.Name != nil && node.Itemtype == OPCLASS_ITEM_OPERATOR && len(node.Name.Objargs.Items) == 0 {
+		if names, err := node.Name.Objname.DeparseList(Context_Operator); err != nil {
+			return nil, err
+		} else {
+			out = append(out, strings.Join(names, "."))
+		}
+	} else if node.Name != nil {
+		nameCtx := Context_None
+		if node.Itemtype == OPCLASS_ITEM_OPERATOR {
+			nameCtx = Context_Operator
+		}
+
+		if str, err := deparseNode(*node.Name, nameCtx); err != nil {
+			return nil, err
+		} else {
+			out = append(out, *str)
+		}
+	}
+
+	if node.OrderFamily.Items != nil && len(node.OrderFamily.Items) > 0 {
+		if names, err := node.OrderFamily.DeparseList(Context_None); err != nil {
+			return nil, err
+		} else {
+			out = append(out, "FOR ORDER BY", strings.Join(names, "."))
+		}
+	}
+
+	result := strings.Join(out, " ")
+	return &result, nil
 }
@@ -2,6 +2,51 @@
 
 package pg_query
 
+import (
+	"strings"
+
+	"github.com/juju/errors"
+)
+
 func (node CreateOpClassStmt) Deparse(ctx Context) (*string, error) {
-	panic("Not Implemented")
+	if node.Datatype == nil || node.Amname == nil {
+		return nil, errors.New("type and access method cannot be null in create operator class")
+	}
+
+	out := []string{"CREATE OPERATOR CLASS"}
+	if names, err := node.Opclassname.DeparseList(Context_None); err != nil {
+		return nil, err
+	} else {
+		out = append(out, strings.Join(names, "."))
+	}
+
+	if node.IsDefault {
+		out = append(out, "DEFAULT")
+	}
+
+	out = append(out, "FOR TYPE")
+	if str, err := deparseNode(*node.Datatype, Context_None); err != nil {
+		return nil, err
+	} else {
+		out = append(out, *str)
+	}
+
+	out = append(out, "USING", quoteIdentifier(*node.Amname))
+
+	if node.Opfamilyname.Items != nil && len(node.Opfamilyname.Items) > 0 {
+		if names, err := node.Opfamilyname.DeparseList(Context_None); err != nil {
+			return nil, err
+		} else {
+			out = append(out, "FAMILY", strings.Join(names, "."))
+		}
+	}
+
+	if items, err := node.Items.DeparseList(Context_None); err != nil {
+		return nil, err
+	} else {
+		out = append(out, "AS", strings.Join(items, ", "))
+	}
+
+	result := strings.Join(out, " ")
+	return &result, nil
 }
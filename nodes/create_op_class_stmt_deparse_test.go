@@ -0,0 +1,105 @@
+/*
+ * Copyright (c) 2019 Ready Stock
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+package pg_query
+
+import (
+	"testing"
+)
+
+func Test_CreateOpClassStmt(t *testing.T) {
+	DoTest(t, DeparseTest{
+		Query:    `CREATE OPERATOR CLASS box_ops DEFAULT FOR TYPE box USING gist FAMILY box_fam AS OPERATOR 1 <<, OPERATOR 2 &< (box, box), OPERATOR 15 <-> (box, point) FOR ORDER BY float_ops, FUNCTION 1 gist_box_consistent(internal, box, int2, oid, internal), STORAGE box;`,
+		Expected: `CREATE OPERATOR CLASS "box_ops" DEFAULT FOR TYPE box USING gist FAMILY "box_fam" AS OPERATOR 1 <<, OPERATOR 2 &<(box, box), OPERATOR 15 <->(box, point) FOR ORDER BY "float_ops", FUNCTION 1 gist_box_consistent(internal, box, int2, oid, internal), STORAGE box`,
+	})
+}
+
+func Test_CreateOpFamilyStmt(t *testing.T) {
+	DoTest(t, DeparseTest{
+		Query:    `CREATE OPERATOR FAMILY alt_opf1 USING hash;`,
+		Expected: `CREATE OPERATOR FAMILY "alt_opf1" USING hash`,
+	})
+}
+
+func Test_AlterOpFamilyStmt_Add(t *testing.T) {
+	DoTest(t, DeparseTest{
+		Query:    `ALTER OPERATOR FAMILY alt_opf4 USING btree ADD OPERATOR 1 < (int4, int2), FUNCTION 1 (int4, int2) btint42cmp(int4, int2);`,
+		Expected: `ALTER OPERATOR FAMILY "alt_opf4" USING btree ADD OPERATOR 1 <(int4, int2), FUNCTION 1 (int4, int2) btint42cmp(int4, int2)`,
+	})
+}
+
+func Test_AlterOpFamilyStmt_Drop(t *testing.T) {
+	DoTest(t, DeparseTest{
+		Query:    `ALTER OPERATOR FAMILY alt_opf4 USING btree DROP OPERATOR 1 (int4, int2), FUNCTION 1 (int4, int2);`,
+		Expected: `ALTER OPERATOR FAMILY "alt_opf4" USING btree DROP OPERATOR 1 (int4, int2), FUNCTION 1 (int4, int2)`,
+	})
+}
+
+func Test_AlterOperatorStmt(t *testing.T) {
+	DoTest(t, DeparseTest{
+		Query:    `ALTER OPERATOR === (boolean, boolean) SET (RESTRICT = NONE, JOIN = customcontsel);`,
+		Expected: `ALTER OPERATOR ===(boolean, boolean) SET (restrict = NONE, join = customcontsel)`,
+	})
+}
+
+func Test_AlterOperatorStmt_Prefix(t *testing.T) {
+	DoTest(t, DeparseTest{
+		Query:    `ALTER OPERATOR @- (NONE, int4) SET (restrict = contsel);`,
+		Expected: `ALTER OPERATOR @-(NONE, int4) SET (restrict = contsel)`,
+	})
+}
+
+func Test_CreateCastStmt_Function(t *testing.T) {
+	DoTest(t, DeparseTest{
+		Query:    `CREATE CAST (int4 AS casttesttype) WITH FUNCTION int4_casttesttype(int4) AS IMPLICIT;`,
+		Expected: `CREATE CAST (int4 AS casttesttype) WITH FUNCTION int4_casttesttype(int4) AS IMPLICIT`,
+	})
+}
+
+func Test_CreateCastStmt_Inout(t *testing.T) {
+	DoTest(t, DeparseTest{
+		Query:    `CREATE CAST (text AS casttesttype) WITH INOUT AS ASSIGNMENT;`,
+		Expected: `CREATE CAST (text AS casttesttype) WITH INOUT AS ASSIGNMENT`,
+	})
+}
+
+func Test_CreateCastStmt_WithoutFunction(t *testing.T) {
+	DoTest(t, DeparseTest{
+		Query:    `CREATE CAST (text AS casttesttype) WITHOUT FUNCTION;`,
+		Expected: `CREATE CAST (text AS casttesttype) WITHOUT FUNCTION`,
+	})
+}
+
+func Test_CreateOpFamilyStmt_QuotedAccessMethod(t *testing.T) {
+	DoTest(t, DeparseTest{
+		Query:    `CREATE OPERATOR FAMILY f USING "Gist"`,
+		Expected: `CREATE OPERATOR FAMILY "f" USING "Gist"`,
+	})
+}
+
+func Test_CreateOpClassStmt_QuotedAccessMethod(t *testing.T) {
+	DoTest(t, DeparseTest{
+		Query:    `CREATE OPERATOR CLASS c FOR TYPE int USING "Btree" AS STORAGE int`,
+		Expected: `CREATE OPERATOR CLASS "c" FOR TYPE int USING "Btree" AS STORAGE int`,
+	})
+}
+
+func Test_DefineStmt_QuotedDefinitionName(t *testing.T) {
+	DoTest(t, DeparseTest{
+		Query:    `CREATE AGGREGATE a (int) (sfunc = f, stype = int, "Custom" = 1)`,
+		Expected: `CREATE AGGREGATE "a" (int) (sfunc = f, stype = int, "Custom" = 1)`,
+	})
+}
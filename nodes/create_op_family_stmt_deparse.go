@@ -2,6 +2,26 @@
 
 package pg_query
 
+import (
+	"strings"
+
+	"github.com/juju/errors"
+)
+
 func (node CreateOpFamilyStmt) Deparse(ctx Context) (*string, error) {
-	panic("Not Implemented")
+	if node.Amname == nil {
+		return nil, errors.New("access method cannot be null in create operator family")
+	}
+
+	out := []string{"CREATE OPERATOR FAMILY"}
+	if names, err := node.Opfamilyname.DeparseList(Context_None); err != nil {
+		return nil, err
+	} else {
+		out = append(out, strings.Join(names, "."))
+	}
+
+	out = append(out, "USING", quoteIdentifier(*node.Amname))
+
+	result := strings.Join(out, " ")
+	return &result, nil
 }
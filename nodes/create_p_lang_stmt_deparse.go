@@ -2,6 +2,54 @@
 
 package pg_query
 
+import (
+	"strings"
+
+	"github.com/juju/errors"
+)
+
 func (node CreatePLangStmt) Deparse(ctx Context) (*string, error) {
-	panic("Not Implemented")
+	out := []string{"CREATE"}
+	if node.Replace {
+		out = append(out, "OR REPLACE")
+	}
+
+	if node.Pltrusted {
+		out = append(out, "TRUSTED")
+	}
+	out = append(out, "LANGUAGE")
+
+	if node.Plname == nil {
+		return nil, errors.New("language name cannot be null in create language")
+	}
+	out = append(out, *node.Plname)
+
+	// Without a handler the language is created from its template in
+	// pg_pltemplate, in which case there are no other functions either.
+	if node.Plhandler.Items != nil && len(node.Plhandler.Items) > 0 {
+		if name, err := node.Plhandler.DeparseList(Context_FuncCall); err != nil {
+			return nil, err
+		} else {
+			out = append(out, "HANDLER", strings.Join(name, "."))
+		}
+
+		if node.Plinline.Items != nil && len(node.Plinline.Items) > 0 {
+			if name, err := node.Plinline.DeparseList(Context_FuncCall); err != nil {
+				return nil, err
+			} else {
+				out = append(out, "INLINE", strings.Join(name, "."))
+			}
+		}
+
+		if node.Plvalidator.Items != nil && len(node.Plvalidator.Items) > 0 {
+			if name, err := node.Plvalidator.DeparseList(Context_FuncCall); err != nil {
+				return nil, err
+			} else {
+				out = append(out, "VALIDATOR", strings.Join(name, "."))
+			}
+		}
+	}
+
+	result := strings.Join(out, " ")
+	return &result, nil
 }
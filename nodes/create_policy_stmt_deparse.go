@@ -2,6 +2,76 @@
 
 package pg_query
 
+import (
+	"fmt"
+	"strings"
+
+	"github.com/juju/errors"
+)
+
 func (node CreatePolicyStmt) Deparse(ctx Context) (*string, error) {
-	panic("Not Implemented")
+	if node.PolicyName == nil || node.Table == nil {
+		return nil, errors.New("policy must have a name and a table")
+	}
+
+	out := []string{"CREATE POLICY", quoteIdentifier(*node.PolicyName)}
+
+	if str, err := node.Table.Deparse(Context_None); err != nil {
+		return nil, err
+	} else {
+		out = append(out, "ON", *str)
+	}
+
+	if !node.Permissive {
+		out = append(out, "AS RESTRICTIVE")
+	}
+
+	if node.CmdName != nil && *node.CmdName != "all" {
+		out = append(out, fmt.Sprintf("FOR %s", strings.ToUpper(*node.CmdName)))
+	}
+
+	if str, err := deparsePolicyClauses(node.Roles, node.Qual, node.WithCheck); err != nil {
+		return nil, err
+	} else if str != nil {
+		out = append(out, *str)
+	}
+
+	result := strings.Join(out, " ")
+	return &result, nil
+}
+
+// deparsePolicyClauses returns the TO, USING and WITH CHECK clauses that are
+// shared by CREATE POLICY and ALTER POLICY, or nil when none are given.
+func deparsePolicyClauses(roles List, qual Node, withCheck Node) (*string, error) {
+	out := make([]string, 0)
+	if roles.Items != nil && len(roles.Items) > 0 {
+		if items, err := roles.DeparseList(Context_None); err != nil {
+			return nil, err
+		} else {
+			out = append(out, fmt.Sprintf("TO %s", strings.Join(items, ", ")))
+		}
+	}
+
+	if qual != nil {
+		if str, err := deparseNode(qual, Context_None); err != nil {
+			return nil, err
+		} else {
+			out = append(out, fmt.Sprintf("USING (%s)", *str))
+		}
+	}
+
+	if withCheck != nil {
+		if str, err := deparseNode(withCheck, Context_None); err != nil {
+			return nil, err
+		} else {
+			out = append(out, fmt.Sprintf("WITH CHECK (%s)", *str))
+		}
+	}
+
+	if len(out) == 0 {
+		return nil, nil
+	}
+
+	result := strings.Join(out, " ")
+	return &result, nil
 }
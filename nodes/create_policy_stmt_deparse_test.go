@@ -0,0 +1,61 @@
+/*
+ * Copyright (c) 2019 Ready Stock
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+package pg_query
+
+import (
+	"testing"
+)
+
+func Test_CreatePolicyStmt(t *testing.T) {
+	DoTest(t, DeparseTest{
+		Query:    `CREATE POLICY account_managers ON accounts TO managers USING (manager = current_user);`,
+		Expected: `CREATE POLICY account_managers ON "accounts" TO managers USING ("manager" = CURRENT_USER)`,
+	})
+	DoTest(t, DeparseTest{
+		Query:    `CREATE POLICY p1 ON document AS RESTRICTIVE FOR UPDATE TO regress_rls_bob, CURRENT_USER USING (dauthor = 'bob') WITH CHECK (dlevel < 2);`,
+		Expected: `CREATE POLICY p1 ON "document" AS RESTRICTIVE FOR UPDATE TO regress_rls_bob, CURRENT_USER USING ("dauthor" = 'bob') WITH CHECK ("dlevel" < 2)`,
+	})
+	DoTest(t, DeparseTest{
+		Query:    `CREATE POLICY p2 ON document FOR INSERT WITH CHECK (true);`,
+		Expected: `CREATE POLICY p2 ON "document" FOR INSERT TO PUBLIC WITH CHECK (true)`,
+	})
+}
+
+func Test_AlterPolicyStmt(t *testing.T) {
+	DoTest(t, DeparseTest{
+		Query:    `ALTER POLICY p1 ON document TO regress_rls_alice USING (dauthor = current_user);`,
+		Expected: `ALTER POLICY p1 ON "document" TO regress_rls_alice USING ("dauthor" = CURRENT_USER)`,
+	})
+	DoTest(t, DeparseTest{
+		Query:    `ALTER POLICY p1 ON document WITH CHECK (dlevel < 3);`,
+		Expected: `ALTER POLICY p1 ON "document" WITH CHECK ("dlevel" < 3)`,
+	})
+}
+
+func Test_CreatePolicyStmt_QuotedName(t *testing.T) {
+	DoTest(t, DeparseTest{
+		Query:    `CREATE POLICY "Pol" ON t USING (true)`,
+		Expected: `CREATE POLICY "Pol" ON "t" TO PUBLIC USING (true)`,
+	})
+}
+
+func Test_AlterPolicyStmt_QuotedName(t *testing.T) {
+	DoTest(t, DeparseTest{
+		Query:    `ALTER POLICY "Pol" ON t USING (true)`,
+		Expected: `ALTER POLICY "Pol" ON "t" USING (true)`,
+	})
+}
@@ -2,6 +2,37 @@
 
 package pg_query
 
+import (
+	"strings"
+
+	"github.com/juju/errors"
+)
+
 func (node CreatePublicationStmt) Deparse(ctx Context) (*string, error) {
-	panic("Not Implemented")
+	if node.Pubname == nil {
+		return nil, errors.New("publication must have a name")
+	}
+
+	out := []string{"CREATE PUBLICATION", quoteIdentifier(*node.Pubname)}
+
+	if node.ForAllTables {
+		out = append(out, "FOR ALL TABLES")
+	} else if node.Tables.Items != nil && len(node.Tables.Items) > 0 {
+		if tables, err := node.Tables.DeparseList(Context_None); err != nil {
+			return nil, err
+		} else {
+			out = append(out, "FOR TABLE", strings.Join(tables, ", "))
+		}
+	}
+
+	if node.Options.Items != nil && len(node.Options.Items) > 0 {
+		if str, err := deparseDefinition(node.Options); err != nil {
+			return nil, err
+		} else {
+			out = append(out, "WITH", *str)
+		}
+	}
+
+	result := strings.Join(out, " ")
+	return &result, nil
 }
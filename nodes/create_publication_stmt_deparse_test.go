@@ -0,0 +1,77 @@
+/*
+ * Copyright (c) 2019 Ready Stock
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+package pg_query
+
+import (
+	"testing"
+)
+
+func Test_CreatePublicationStmt(t *testing.T) {
+	DoTest(t, DeparseTest{
+		Query:    `CREATE PUBLICATION testpub_fortbl FOR TABLE testpub_tbl1, ONLY pub_test.testpub_nopk WITH (publish = 'insert, update');`,
+		Expected: `CREATE PUBLICATION testpub_fortbl FOR TABLE "testpub_tbl1", ONLY "pub_test"."testpub_nopk" WITH (publish = 'insert, update')`,
+	})
+}
+
+func Test_CreatePublicationStmt_AllTables(t *testing.T) {
+	DoTest(t, DeparseTest{
+		Query:    `CREATE PUBLICATION testpub_foralltables FOR ALL TABLES;`,
+		Expected: `CREATE PUBLICATION testpub_foralltables FOR ALL TABLES`,
+	})
+}
+
+func Test_AlterPublicationStmt_Options(t *testing.T) {
+	DoTest(t, DeparseTest{
+		Query:    `ALTER PUBLICATION testpub_default SET (publish = 'insert, update, delete');`,
+		Expected: `ALTER PUBLICATION testpub_default SET (publish = 'insert, update, delete')`,
+	})
+}
+
+func Test_AlterPublicationStmt_AddTable(t *testing.T) {
+	DoTest(t, DeparseTest{
+		Query:    `ALTER PUBLICATION testpub_default ADD TABLE testpub_tbl1, pub_test.testpub_nopk;`,
+		Expected: `ALTER PUBLICATION testpub_default ADD TABLE "testpub_tbl1", "pub_test"."testpub_nopk"`,
+	})
+}
+
+func Test_AlterPublicationStmt_DropTable(t *testing.T) {
+	DoTest(t, DeparseTest{
+		Query:    `ALTER PUBLICATION testpub_default DROP TABLE testpub_tbl1;`,
+		Expected: `ALTER PUBLICATION testpub_default DROP TABLE "testpub_tbl1"`,
+	})
+}
+
+func Test_AlterPublicationStmt_SetTable(t *testing.T) {
+	DoTest(t, DeparseTest{
+		Query:    `ALTER PUBLICATION testpub_default SET TABLE testpub_tbl2;`,
+		Expected: `ALTER PUBLICATION testpub_default SET TABLE "testpub_tbl2"`,
+	})
+}
+
+func Test_CreatePublicationStmt_QuotedName(t *testing.T) {
+	DoTest(t, DeparseTest{
+		Query:    `CREATE PUBLICATION "Pub" FOR ALL TABLES`,
+		Expected: `CREATE PUBLICATION "Pub" FOR ALL TABLES`,
+	})
+}
+
+func Test_AlterPublicationStmt_QuotedName(t *testing.T) {
+	DoTest(t, DeparseTest{
+		Query:    `ALTER PUBLICATION "select" ADD TABLE t`,
+		Expected: `ALTER PUBLICATION "select" ADD TABLE "t"`,
+	})
+}
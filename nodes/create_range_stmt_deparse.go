@@ -2,6 +2,25 @@
 
 package pg_query
 
+import (
+	"strings"
+)
+
 func (node CreateRangeStmt) Deparse(ctx Context) (*string, error) {
-	panic("Not Implemented")
+	out := []string{"CREATE TYPE"}
+	if names, err := node.TypeName.DeparseList(Context_None); err != nil {
+		return nil, err
+	} else {
+		out = append(out, strings.Join(names, "."))
+	}
+
+	out = append(out, "AS RANGE")
+	if str, err := deparseDefinition(node.Params); err != nil {
+		return nil, err
+	} else {
+		out = append(out, *str)
+	}
+
+	result := strings.Join(out, " ")
+	return &result, nil
 }
@@ -2,6 +2,124 @@
 
 package pg_query
 
+import (
+	"fmt"
+	"github.com/juju/errors"
+	"strings"
+)
+
+var (
+	roleStmtTypes = map[RoleStmtType]string{
+		ROLESTMT_ROLE:  "ROLE",
+		ROLESTMT_USER:  "USER",
+		ROLESTMT_GROUP: "GROUP",
+	}
+
+	// roleBoolOptions maps the name of boolean role options to the keywords used
+	// to enable and disable them.
+	roleBoolOptions = map[string][2]string{
+		"superuser":     {"SUPERUSER", "NOSUPERUSER"},
+		"createdb":      {"CREATEDB", "NOCREATEDB"},
+		"createrole":    {"CREATEROLE", "NOCREATEROLE"},
+		"inherit":       {"INHERIT", "NOINHERIT"},
+		"canlogin":      {"LOGIN", "NOLOGIN"},
+		"isreplication": {"REPLICATION", "NOREPLICATION"},
+		"bypassrls":     {"BYPASSRLS", "NOBYPASSRLS"},
+	}
+
+	roleListOptions = map[string]string{
+		"rolemembers":  "ROLE",
+		"adminmembers": "ADMIN",
+		"addroleto":    "IN ROLE",
+	}
+)
+
 func (node CreateRoleStmt) Deparse(ctx Context) (*string, error) {
-	panic("Not Implemented")
+	out := []string{"CREATE"}
+	if stmtType, ok := roleStmtTypes[node.StmtType]; !ok {
+		return nil, errors.Errorf("cannot deparse role statement type (%d)", node.StmtType)
+	} else {
+		out = append(out, stmtType)
+	}
+
+	if node.Role == nil {
+		return nil, errors.New("role name cannot be null in create role")
+	}
+	out = append(out, quoteIdentifier(*node.Role))
+
+	if node.Options.Items != nil && len(node.Options.Items) > 0 {
+		if options, err := deparseRoleOptions(node.Options); err != nil {
+			return nil, err
+		} else {
+			out = append(out, "WITH", *options)
+		}
+	}
+
+	result := strings.Join(out, " ")
+	return &result, nil
+}
+
+func deparseRoleOptions(options List) (*string, error) {
+	out := make([]string, len(options.Items))
+	for i, item := range options.Items {
+		option, ok := item.(DefElem)
+		if !ok || option.Defname == nil {
+			return nil, errors.Errorf("cannot deparse role option of type %T", item)
+		}
+
+		if keywords, ok := roleBoolOptions[*option.Defname]; ok {
+			if enabled, ok := option.Arg.(Integer); !ok {
+				return nil, errors.Errorf("role option %s must be an integer", *option.Defname)
+			} else if enabled.Ival != 0 {
+				out[i] = keywords[0]
+			} else {
+				out[i] = keywords[1]
+			}
+			continue
+		}
+
+		if keyword, ok := roleListOptions[*option.Defname]; ok {
+			if roles, ok := option.Arg.(List); !ok {
+				return nil, errors.Errorf("role option %s must be a list", *option.Defname)
+			} else if roles, err := roles.DeparseList(Context_None); err != nil {
+				return nil, err
+			} else {
+				out[i] = fmt.Sprintf("%s %s", keyword, strings.Join(roles, ", "))
+			}
+			continue
+		}
+
+		switch *option.Defname {
+		case "password":
+			if option.Arg == nil {
+				out[i] = "PASSWORD NULL"
+			} else if str, err := deparseNode(option.Arg, Context_AConst); err != nil {
+				return nil, err
+			} else {
+				out[i] = fmt.Sprintf("PASSWORD %s", *str)
+			}
+		case "connectionlimit":
+			if str, err := deparseNode(option.Arg, Context_None); err != nil {
+				return nil, err
+			} else {
+				out[i] = fmt.Sprintf("CONNECTION LIMIT %s", *str)
+			}
+		case "validUntil":
+			if str, err := deparseNode(option.Arg, Context_AConst); err != nil {
+				return nil, err
+			} else {
+				out[i] = fmt.Sprintf("VALID UNTIL %s", *str)
+			}
+		case "sysid":
+			if str, err := deparseNode(option.Arg, Context_None); err != nil {
+				return nil, err
+			} else {
+				out[i] = fmt.Sprintf("SYSID %s", *str)
+			}
+		default:
+			return nil, errors.Errorf("cannot deparse role option: %s", *option.Defname)
+		}
+	}
+	result := strings.Join(out, " ")
+	return &result, nil
 }
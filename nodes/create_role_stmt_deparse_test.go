@@ -0,0 +1,125 @@
+/*
+ * Copyright (c) 2019 Ready Stock
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+package pg_query
+
+import (
+	"testing"
+)
+
+func Test_CreateRoleStmt(t *testing.T) {
+	DoTest(t, DeparseTest{
+		Query:    `CREATE USER regress_seq_user;`,
+		Expected: `CREATE USER regress_seq_user`,
+	})
+	DoTest(t, DeparseTest{
+		Query:    `CREATE USER regress_rls_exempt_user BYPASSRLS NOLOGIN;`,
+		Expected: `CREATE USER regress_rls_exempt_user WITH BYPASSRLS NOLOGIN`,
+	})
+	DoTest(t, DeparseTest{
+		Query:    `CREATE ROLE regress_app WITH LOGIN ENCRYPTED PASSWORD 'it''s secret' CONNECTION LIMIT 10 VALID UNTIL 'infinity' IN ROLE admins ADMIN bob;`,
+		Expected: `CREATE ROLE regress_app WITH LOGIN PASSWORD 'it''s secret' CONNECTION LIMIT 10 VALID UNTIL 'infinity' IN ROLE admins ADMIN bob`,
+	})
+	DoTest(t, DeparseTest{
+		Query:    `CREATE GROUP regress_group1 WITH USER regress_user1, regress_user2;`,
+		Expected: `CREATE GROUP regress_group1 WITH ROLE regress_user1, regress_user2`,
+	})
+}
+
+func Test_AlterRoleStmt(t *testing.T) {
+	DoTest(t, DeparseTest{
+		Query:    `ALTER ROLE regress_test_createdb WITH CREATEDB NOSUPERUSER;`,
+		Expected: `ALTER ROLE regress_test_createdb WITH CREATEDB NOSUPERUSER`,
+	})
+	DoTest(t, DeparseTest{
+		Query:    `ALTER USER SESSION_USER WITH REPLICATION;`,
+		Expected: `ALTER ROLE SESSION_USER WITH REPLICATION`,
+	})
+	DoTest(t, DeparseTest{
+		Query:    `ALTER ROLE regress_passwd2 PASSWORD NULL;`,
+		Expected: `ALTER ROLE regress_passwd2 WITH PASSWORD NULL`,
+	})
+	DoTest(t, DeparseTest{
+		Query:    `ALTER GROUP regress_group1 ADD USER regress_user4, regress_user5;`,
+		Expected: `ALTER GROUP regress_group1 ADD USER regress_user4, regress_user5`,
+	})
+	DoTest(t, DeparseTest{
+		Query:    `ALTER GROUP regress_group1 DROP USER regress_user4;`,
+		Expected: `ALTER GROUP regress_group1 DROP USER regress_user4`,
+	})
+}
+
+func Test_AlterRoleSetStmt(t *testing.T) {
+	DoTest(t, DeparseTest{
+		Query:    `ALTER ROLE "current_user" SET application_name to 'FOOFOO';`,
+		Expected: `ALTER ROLE "current_user" SET application_name TO 'FOOFOO'`,
+	})
+	DoTest(t, DeparseTest{
+		Query:    `ALTER USER ALL IN DATABASE regression SET search_path TO public, extensions;`,
+		Expected: `ALTER ROLE ALL IN DATABASE regression SET search_path TO 'public', 'extensions'`,
+	})
+	DoTest(t, DeparseTest{
+		Query:    `ALTER ROLE SESSION_USER RESET application_name;`,
+		Expected: `ALTER ROLE SESSION_USER RESET application_name`,
+	})
+	DoTest(t, DeparseTest{
+		Query:    `ALTER ROLE regress_user1 RESET ALL;`,
+		Expected: `ALTER ROLE regress_user1 RESET ALL`,
+	})
+	DoTest(t, DeparseTest{
+		Query:    `ALTER ROLE regress_user1 SET work_mem FROM CURRENT;`,
+		Expected: `ALTER ROLE regress_user1 SET work_mem FROM CURRENT`,
+	})
+}
+
+func Test_DropRoleStmt(t *testing.T) {
+	DoTest(t, DeparseTest{
+		Query:    `DROP USER IF EXISTS regress_rls_exempt_user, regress_rls_alice;`,
+		Expected: `DROP ROLE IF EXISTS regress_rls_exempt_user, regress_rls_alice`,
+	})
+}
+
+func Test_OwnedStmts(t *testing.T) {
+	DoTest(t, DeparseTest{
+		Query:    `DROP OWNED BY regress_test_role2 CASCADE;`,
+		Expected: `DROP OWNED BY regress_test_role2 CASCADE`,
+	})
+	DoTest(t, DeparseTest{
+		Query:    `REASSIGN OWNED BY regress_dep_user1, CURRENT_USER TO regress_dep_user2;`,
+		Expected: `REASSIGN OWNED BY regress_dep_user1, CURRENT_USER TO regress_dep_user2`,
+	})
+}
+
+func Test_CreateRoleStmt_QuotedName(t *testing.T) {
+	DoTest(t, DeparseTest{
+		Query:    `CREATE ROLE "Bob" WITH LOGIN IN ROLE "Admins", "public";`,
+		Expected: `CREATE ROLE "Bob" WITH LOGIN IN ROLE "Admins", PUBLIC`,
+	})
+}
+
+func Test_AlterRoleSetStmt_QuotedDatabase(t *testing.T) {
+	DoTest(t, DeparseTest{
+		Query:    `ALTER ROLE "Bob" IN DATABASE "Db" SET search_path TO app;`,
+		Expected: `ALTER ROLE "Bob" IN DATABASE "Db" SET search_path TO 'app'`,
+	})
+}
+
+func Test_DropOwnedStmt_QuotedNames(t *testing.T) {
+	DoTest(t, DeparseTest{
+		Query:    `DROP OWNED BY "Public", "current_user", CURRENT_USER;`,
+		Expected: `DROP OWNED BY "Public", "current_user", CURRENT_USER`,
+	})
+}
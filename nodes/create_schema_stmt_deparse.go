@@ -2,6 +2,37 @@
 
 package pg_query
 
+import (
+	"strings"
+)
+
 func (node CreateSchemaStmt) Deparse(ctx Context) (*string, error) {
-	panic("Not Implemented")
+	out := []string{"CREATE SCHEMA"}
+	if node.IfNotExists {
+		out = append(out, "IF NOT EXISTS")
+	}
+
+	// The name can be omitted, in which case the name of the role is used.
+	if node.Schemaname != nil {
+		out = append(out, quoteIdentifier(*node.Schemaname))
+	}
+
+	if node.Authrole != nil {
+		if str, err := deparseNode(*node.Authrole, Context_None); err != nil {
+			return nil, err
+		} else {
+			out = append(out, "AUTHORIZATION", *str)
+		}
+	}
+
+	if node.SchemaElts.Items != nil && len(node.SchemaElts.Items) > 0 {
+		if elements, err := node.SchemaElts.DeparseList(Context_None); err != nil {
+			return nil, err
+		} else {
+			out = append(out, elements...)
+		}
+	}
+
+	result := strings.Join(out, " ")
+	return &result, nil
 }
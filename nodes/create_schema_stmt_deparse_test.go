@@ -0,0 +1,238 @@
+/*
+ * Copyright (c) 2019 Ready Stock
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+package pg_query
+
+import (
+	"testing"
+)
+
+func Test_CreateSchema(t *testing.T) {
+	DoTest(t, DeparseTest{
+		Query:    `CREATE SCHEMA IF NOT EXISTS test_schema AUTHORIZATION regress_user;`,
+		Expected: `CREATE SCHEMA IF NOT EXISTS test_schema AUTHORIZATION regress_user`,
+	})
+}
+
+func Test_CreateSchema_Elements(t *testing.T) {
+	DoTest(t, DeparseTest{
+		Query:    `CREATE SCHEMA test_schema CREATE TABLE abc (a serial, b int) CREATE VIEW abc_view AS SELECT a + 1 AS a FROM abc;`,
+		Expected: `CREATE SCHEMA test_schema CREATE TABLE "abc" (a serial, b int) CREATE VIEW "abc_view" AS SELECT "a" + 1 AS a FROM "abc"`,
+	})
+}
+
+func Test_Createdb(t *testing.T) {
+	DoTest(t, DeparseTest{
+		Query:    `CREATE DATABASE regression_db WITH OWNER = regress_user TEMPLATE = template0 ENCODING = 'UTF8' CONNECTION LIMIT = 10;`,
+		Expected: `CREATE DATABASE regression_db WITH OWNER = 'regress_user' TEMPLATE = 'template0' ENCODING = 'UTF8' CONNECTION LIMIT = 10`,
+	})
+}
+
+func Test_AlterDatabase(t *testing.T) {
+	DoTest(t, DeparseTest{
+		Query:    `ALTER DATABASE regression_db WITH ALLOW_CONNECTIONS false CONNECTION LIMIT -1;`,
+		Expected: `ALTER DATABASE regression_db WITH ALLOW_CONNECTIONS = 'false' CONNECTION LIMIT = -1`,
+	})
+}
+
+func Test_AlterDatabase_Tablespace(t *testing.T) {
+	DoTest(t, DeparseTest{
+		Query:    `ALTER DATABASE regression_db SET TABLESPACE fast;`,
+		Expected: `ALTER DATABASE regression_db WITH TABLESPACE = 'fast'`,
+	})
+}
+
+func Test_AlterDatabaseSet(t *testing.T) {
+	DoTest(t, DeparseTest{
+		Query:    `ALTER DATABASE regression_db SET search_path TO public, app;`,
+		Expected: `ALTER DATABASE regression_db SET search_path TO 'public', 'app'`,
+	})
+}
+
+func Test_CreateTableSpace(t *testing.T) {
+	DoTest(t, DeparseTest{
+		Query:    `CREATE TABLESPACE regress_tblspace OWNER regress_user LOCATION '/data/tblspc' WITH (random_page_cost = 3.0);`,
+		Expected: `CREATE TABLESPACE regress_tblspace OWNER regress_user LOCATION '/data/tblspc' WITH (random_page_cost=3.0)`,
+	})
+}
+
+func Test_DropTableSpace(t *testing.T) {
+	DoTest(t, DeparseTest{
+		Query:    `DROP TABLESPACE IF EXISTS regress_tblspace;`,
+		Expected: `DROP TABLESPACE IF EXISTS regress_tblspace`,
+	})
+}
+
+func Test_AlterTableSpaceOptions(t *testing.T) {
+	DoTest(t, DeparseTest{
+		Query:    `ALTER TABLESPACE regress_tblspace SET (random_page_cost = 1.0, seq_page_cost = 1.1);`,
+		Expected: `ALTER TABLESPACE regress_tblspace SET (random_page_cost=1.0, seq_page_cost=1.1)`,
+	})
+}
+
+func Test_AlterTableSpaceOptions_Reset(t *testing.T) {
+	DoTest(t, DeparseTest{
+		Query:    `ALTER TABLESPACE regress_tblspace RESET (random_page_cost);`,
+		Expected: `ALTER TABLESPACE regress_tblspace RESET (random_page_cost)`,
+	})
+}
+
+func Test_AlterTableMoveAll(t *testing.T) {
+	DoTest(t, DeparseTest{
+		Query:    `ALTER INDEX ALL IN TABLESPACE regress_tblspace OWNED BY regress_user, CURRENT_USER SET TABLESPACE pg_default NOWAIT;`,
+		Expected: `ALTER INDEX ALL IN TABLESPACE regress_tblspace OWNED BY regress_user, CURRENT_USER SET TABLESPACE pg_default NOWAIT`,
+	})
+}
+
+func Test_CreateExtension(t *testing.T) {
+	DoTest(t, DeparseTest{
+		Query:    `CREATE EXTENSION IF NOT EXISTS hstore WITH SCHEMA public VERSION '1.4' CASCADE;`,
+		Expected: `CREATE EXTENSION IF NOT EXISTS hstore WITH SCHEMA public VERSION '1.4' CASCADE`,
+	})
+}
+
+func Test_CreateExtension_From(t *testing.T) {
+	DoTest(t, DeparseTest{
+		Query:    `CREATE EXTENSION hstore FROM unpackaged;`,
+		Expected: `CREATE EXTENSION hstore WITH FROM 'unpackaged'`,
+	})
+}
+
+func Test_AlterExtension(t *testing.T) {
+	DoTest(t, DeparseTest{
+		Query:    `ALTER EXTENSION hstore UPDATE TO '1.5';`,
+		Expected: `ALTER EXTENSION hstore UPDATE TO '1.5'`,
+	})
+}
+
+func Test_AlterExtension_Default(t *testing.T) {
+	DoTest(t, DeparseTest{
+		Query:    `ALTER EXTENSION hstore UPDATE;`,
+		Expected: `ALTER EXTENSION hstore UPDATE`,
+	})
+}
+
+func Test_AlterExtensionContents(t *testing.T) {
+	DoTest(t, DeparseTest{
+		Query:    `ALTER EXTENSION hstore ADD FUNCTION public.fetchval(hstore, text);`,
+		Expected: `ALTER EXTENSION hstore ADD FUNCTION public.fetchval(hstore, text)`,
+	})
+}
+
+func Test_AlterExtensionContents_Drop(t *testing.T) {
+	DoTest(t, DeparseTest{
+		Query:    `ALTER EXTENSION hstore DROP OPERATOR CLASS gist_hstore_ops USING gist;`,
+		Expected: `ALTER EXTENSION hstore DROP OPERATOR CLASS "gist_hstore_ops" USING "gist"`,
+	})
+}
+
+func Test_CreateSchemaStmt_QuotedNames(t *testing.T) {
+	DoTest(t, DeparseTest{
+		Query:    `CREATE SCHEMA "S" AUTHORIZATION "Bob"`,
+		Expected: `CREATE SCHEMA "S" AUTHORIZATION "Bob"`,
+	})
+}
+
+func Test_CreateExtensionStmt_QuotedNames(t *testing.T) {
+	DoTest(t, DeparseTest{
+		Query:    `CREATE EXTENSION IF NOT EXISTS "uuid-ossp" SCHEMA "S"`,
+		Expected: `CREATE EXTENSION IF NOT EXISTS "uuid-ossp" WITH SCHEMA "S"`,
+	})
+}
+
+func Test_AlterExtensionStmt_QuotedName(t *testing.T) {
+	DoTest(t, DeparseTest{
+		Query:    `ALTER EXTENSION "uuid-ossp" UPDATE`,
+		Expected: `ALTER EXTENSION "uuid-ossp" UPDATE`,
+	})
+}
+
+func Test_AlterExtensionContentsStmt_QuotedName(t *testing.T) {
+	DoTest(t, DeparseTest{
+		Query:    `ALTER EXTENSION "Ext" ADD TABLE t`,
+		Expected: `ALTER EXTENSION "Ext" ADD TABLE "t"`,
+	})
+}
+
+func Test_CreateTableSpaceStmt_QuotedName(t *testing.T) {
+	DoTest(t, DeparseTest{
+		Query:    `CREATE TABLESPACE "Ts" LOCATION '/data'`,
+		Expected: `CREATE TABLESPACE "Ts" LOCATION '/data'`,
+	})
+}
+
+func Test_DropTableSpaceStmt_QuotedName(t *testing.T) {
+	DoTest(t, DeparseTest{
+		Query:    `DROP TABLESPACE "Ts"`,
+		Expected: `DROP TABLESPACE "Ts"`,
+	})
+}
+
+func Test_AlterTableSpaceOptionsStmt_QuotedName(t *testing.T) {
+	DoTest(t, DeparseTest{
+		Query:    `ALTER TABLESPACE "Ts" SET (random_page_cost = 1)`,
+		Expected: `ALTER TABLESPACE "Ts" SET (random_page_cost=1)`,
+	})
+}
+
+func Test_AlterTableMoveAllStmt_QuotedNames(t *testing.T) {
+	DoTest(t, DeparseTest{
+		Query:    `ALTER TABLE ALL IN TABLESPACE "Ts" SET TABLESPACE "select"`,
+		Expected: `ALTER TABLE ALL IN TABLESPACE "Ts" SET TABLESPACE "select"`,
+	})
+}
+
+func Test_CreatedbStmt_QuotedName(t *testing.T) {
+	DoTest(t, DeparseTest{
+		Query:    `CREATE DATABASE "Db"`,
+		Expected: `CREATE DATABASE "Db"`,
+	})
+}
+
+func Test_AlterDatabaseStmt_QuotedName(t *testing.T) {
+	DoTest(t, DeparseTest{
+		Query:    `ALTER DATABASE "Db" CONNECTION LIMIT 5`,
+		Expected: `ALTER DATABASE "Db" WITH CONNECTION LIMIT = 5`,
+	})
+}
+
+func Test_AlterDatabaseSetStmt_QuotedName(t *testing.T) {
+	DoTest(t, DeparseTest{
+		Query:    `ALTER DATABASE "Db" SET work_mem TO 1`,
+		Expected: `ALTER DATABASE "Db" SET work_mem TO 1`,
+	})
+}
+
+func Test_AlterObjectSchemaStmt_QuotedSchema(t *testing.T) {
+	DoTest(t, DeparseTest{
+		Query:    `ALTER TABLE t SET SCHEMA "S"`,
+		Expected: `ALTER TABLE "t" SET SCHEMA "S"`,
+	})
+}
+
+func Test_SecLabelStmt_QuotedProvider(t *testing.T) {
+	DoTest(t, DeparseTest{
+		Query:    `SECURITY LABEL FOR "Selinux" ON TABLE t IS 'x'`,
+		Expected: `SECURITY LABEL FOR "Selinux" ON TABLE "t" IS 'x'`,
+	})
+}
+
+func Test_DropdbStmt_QuotedName(t *testing.T) {
+	DoTest(t, DeparseTest{
+		Query:    `DROP DATABASE IF EXISTS "Db"`,
+		Expected: `DROP DATABASE IF EXISTS "Db"`,
+	})
+}
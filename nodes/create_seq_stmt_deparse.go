@@ -2,6 +2,39 @@
 
 package pg_query
 
+import (
+	"strings"
+
+	"github.com/juju/errors"
+)
+
 func (node CreateSeqStmt) Deparse(ctx Context) (*string, error) {
-	panic("Not Implemented")
+	if node.Sequence == nil {
+		return nil, errors.New("sequence cannot be null in create sequence")
+	}
+
+	out := []string{"CREATE"}
+	if persistence := node.Sequence.relPersistence(); persistence != nil {
+		out = append(out, *persistence)
+	}
+	out = append(out, "SEQUENCE")
+
+	if node.IfNotExists {
+		out = append(out, "IF NOT EXISTS")
+	}
+
+	if str, err := deparseNode(*node.Sequence, Context_None); err != nil {
+		return nil, err
+	} else {
+		out = append(out, *str)
+	}
+
+	if options, err := deparseSeqOptions(node.Options); err != nil {
+		return nil, err
+	} else {
+		out = append(out, options...)
+	}
+
+	result := strings.Join(out, " ")
+	return &result, nil
 }
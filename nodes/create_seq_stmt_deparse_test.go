@@ -0,0 +1,56 @@
+/*
+ * Copyright (c) 2019 Ready Stock
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+package pg_query
+
+import (
+	"testing"
+)
+
+func Test_CreateSeqStmt(t *testing.T) {
+	DoTest(t, DeparseTest{
+		Query:    `CREATE TEMP SEQUENCE IF NOT EXISTS sequence_test AS bigint INCREMENT 2 MINVALUE 1 NO MAXVALUE START WITH 10 CACHE 10 CYCLE OWNED BY t.c;`,
+		Expected: `CREATE TEMPORARY SEQUENCE IF NOT EXISTS "sequence_test" AS bigint INCREMENT BY 2 MINVALUE 1 NO MAXVALUE START WITH 10 CACHE 10 CYCLE OWNED BY "t"."c"`,
+	})
+}
+
+func Test_CreateSeqStmt_Unlogged(t *testing.T) {
+	DoTest(t, DeparseTest{
+		Query:    `CREATE UNLOGGED SEQUENCE sequence_test2 INCREMENT BY -1 MAXVALUE 100 NO MINVALUE NO CYCLE;`,
+		Expected: `CREATE UNLOGGED SEQUENCE "sequence_test2" INCREMENT BY -1 MAXVALUE 100 NO MINVALUE NO CYCLE`,
+	})
+}
+
+func Test_AlterSeqStmt(t *testing.T) {
+	DoTest(t, DeparseTest{
+		Query:    `ALTER SEQUENCE IF EXISTS sequence_test2 RESTART WITH 24 INCREMENT BY 4 MAXVALUE 36 MINVALUE 5 CYCLE;`,
+		Expected: `ALTER SEQUENCE IF EXISTS "sequence_test2" RESTART WITH 24 INCREMENT BY 4 MAXVALUE 36 MINVALUE 5 CYCLE`,
+	})
+}
+
+func Test_AlterSeqStmt_Restart(t *testing.T) {
+	DoTest(t, DeparseTest{
+		Query:    `ALTER SEQUENCE sequence_test2 RESTART OWNED BY NONE;`,
+		Expected: `ALTER SEQUENCE "sequence_test2" RESTART OWNED BY NONE`,
+	})
+}
+
+func Test_AlterSeqStmt_OwnedByQuoted(t *testing.T) {
+	DoTest(t, DeparseTest{
+		Query:    `ALTER SEQUENCE s OWNED BY "T"."C";`,
+		Expected: `ALTER SEQUENCE "s" OWNED BY "T"."C"`,
+	})
+}
@@ -2,6 +2,44 @@
 
 package pg_query
 
+import (
+	"fmt"
+	"strings"
+)
+
 func (node CreateStatsStmt) Deparse(ctx Context) (*string, error) {
-	panic("Not Implemented")
+	out := []string{"CREATE STATISTICS"}
+	if node.IfNotExists {
+		out = append(out, "IF NOT EXISTS")
+	}
+
+	if name, err := node.Defnames.DeparseList(Context_None); err != nil {
+		return nil, err
+	} else {
+		out = append(out, strings.Join(name, "."))
+	}
+
+	// When no kinds are given all supported kinds of statistics are built.
+	if node.StatTypes.Items != nil && len(node.StatTypes.Items) > 0 {
+		if types, err := node.StatTypes.DeparseList(Context_FuncCall); err != nil {
+			return nil, err
+		} else {
+			out = append(out, fmt.Sprintf("(%s)", strings.Join(types, ", ")))
+		}
+	}
+
+	if exprs, err := node.Exprs.DeparseList(Context_None); err != nil {
+		return nil, err
+	} else {
+		out = append(out, "ON", strings.Join(exprs, ", "))
+	}
+
+	if relations, err := node.Relations.DeparseList(Context_None); err != nil {
+		return nil, err
+	} else {
+		out = append(out, "FROM", strings.Join(relations, ", "))
+	}
+
+	result := strings.Join(out, " ")
+	return &result, nil
 }
@@ -5,16 +5,40 @@ package pg_query
 import (
 	"fmt"
 	"strings"
+
+	"github.com/juju/errors"
 )
 
 func (node CreateStmt) Deparse(ctx Context) (*string, error) {
+	if node.Relation == nil {
+		return nil, errors.New("relation cannot be null in create table")
+	}
+
 	out := []string{"CREATE"}
-	if persistence := node.relPersistence(); persistence != nil {
+	if persistence := node.Relation.relPersistence(); persistence != nil {
 		out = append(out, *persistence)
 	}
 
 	out = append(out, "TABLE")
 
+	if str, err := node.deparseTable(); err != nil {
+		return nil, err
+	} else {
+		out = append(out, *str)
+	}
+
+	result := strings.Join(out, " ")
+	return &result, nil
+}
+
+// deparseTable returns everything following the TABLE keyword, this is shared
+// with foreign tables which are defined the same way as regular tables.
+func (node CreateStmt) deparseTable() (*string, error) {
+	if node.Relation == nil {
+		return nil, errors.New("relation cannot be null in create table")
+	}
+
+	out := make([]string, 0)
 	if node.IfNotExists {
 		out = append(out, "IF NOT EXISTS")
 	}
@@ -25,17 +49,39 @@ func (node CreateStmt) Deparse(ctx Context) (*string, error) {
 		out = append(out, *str)
 	}
 
-	elts := make([]string, len(node.TableElts.Items))
-	for i, elt := range node.TableElts.Items {
-		if str, err := deparseNode(elt, Context_None); err != nil {
+	// Partitions and typed tables get their columns from elsewhere, so they
+	// only list column options and constraints when there are any.
+	if node.Partbound != nil {
+		if len(node.InhRelations.Items) != 1 {
+			return nil, errors.New("partition must have exactly one parent")
+		}
+
+		if str, err := deparseNode(node.InhRelations.Items[0], Context_None); err != nil {
 			return nil, err
 		} else {
-			elts[i] = *str
+			out = append(out, fmt.Sprintf("PARTITION OF %s", *str))
 		}
+	} else if node.OfTypename != nil {
+		if str, err := node.OfTypename.Deparse(Context_None); err != nil {
+			return nil, err
+		} else {
+			out = append(out, fmt.Sprintf("OF %s", *str))
+		}
+	}
+
+	if elts, err := node.TableElts.DeparseList(Context_None); err != nil {
+		return nil, err
+	} else if len(elts) > 0 || (node.Partbound == nil && node.OfTypename == nil) {
+		out = append(out, fmt.Sprintf("(%s)", strings.Join(elts, ", ")))
 	}
-	out = append(out, fmt.Sprintf("(%s)", strings.Join(elts, ", ")))
 
-	if node.InhRelations.Items != nil && len(node.InhRelations.Items) > 0 {
+	if node.Partbound != nil {
+		if str, err := node.Partbound.Deparse(Context_None); err != nil {
+			return nil, err
+		} else {
+			out = append(out, *str)
+		}
+	} else if node.InhRelations.Items != nil && len(node.InhRelations.Items) > 0 {
 		out = append(out, "INHERITS")
 		relations := make([]string, len(node.InhRelations.Items))
 		for i, relation := range node.InhRelations.Items {
@@ -48,6 +94,28 @@ func (node CreateStmt) Deparse(ctx Context) (*string, error) {
 		out = append(out, fmt.Sprintf("(%s)", strings.Join(relations, ", ")))
 	}
 
+	if node.Partspec != nil {
+		if str, err := node.Partspec.Deparse(Context_None); err != nil {
+			return nil, err
+		} else {
+			out = append(out, *str)
+		}
+	}
+
+	if node.Options.Items != nil && len(node.Options.Items) > 0 {
+		if str, err := deparseRelOptions(node.Options); err != nil {
+			return nil, err
+		} else {
+			out = append(out, fmt.Sprintf("WITH %s", *str))
+		}
+	}
+
+	if action, ok := onCommitActions[node.Oncommit]; !ok {
+		return nil, errors.Errorf("cannot deparse on commit action (%d)", node.Oncommit)
+	} else if action != "" {
+		out = append(out, action)
+	}
+
 	if node.Tablespacename != nil {
 		out = append(out, fmt.Sprintf(`TABLESPACE "%s"`, *node.Tablespacename))
 	}
@@ -55,13 +123,3 @@ func (node CreateStmt) Deparse(ctx Context) (*string, error) {
 	result := strings.Join(out, " ")
 	return &result, nil
 }
-
-func (node CreateStmt) relPersistence() *string {
-	t, u := "TEMPORARY", "UNLOGGED"
-	if string(node.Relation.Relpersistence) == "t" {
-		return &t
-	} else if string(node.Relation.Relpersistence) == "u" {
-		return &u
-	}
-	return nil
-}
@@ -24,3 +24,55 @@ func Test_CreateStmt_ReferenceColumn(t *testing.T) {
 		Expected: `CREATE TABLE "public"."users" (user_id bigserial PRIMARY KEY, account_id bigint NOT NULL REFERENCES "public"."accounts" ("account_id"), user_number bigint)`,
 	})
 }
+
+func Test_CreateStmt_PartitionBy(t *testing.T) {
+	DoTest(t, DeparseTest{
+		Query:    `CREATE TABLE measurement (city_id int NOT NULL, logdate date NOT NULL) PARTITION BY RANGE (logdate);`,
+		Expected: `CREATE TABLE "measurement" (city_id int NOT NULL, logdate date NOT NULL) PARTITION BY RANGE ("logdate")`,
+	})
+	DoTest(t, DeparseTest{
+		Query:    `CREATE TABLE partitioned (a int, b text) PARTITION BY LIST (lower(b) COLLATE "C" text_pattern_ops);`,
+		Expected: `CREATE TABLE "partitioned" (a int, b text) PARTITION BY LIST (lower("b") COLLATE "C" text_pattern_ops)`,
+	})
+	DoTest(t, DeparseTest{
+		Query:    `CREATE TABLE partitioned (a int, b int) PARTITION BY RANGE (a, (a + b));`,
+		Expected: `CREATE TABLE "partitioned" (a int, b int) PARTITION BY RANGE ("a", ("a" + "b"))`,
+	})
+	DoTest(t, DeparseTest{
+		Query:    `CREATE TABLE partitioned (a int, b int) PARTITION BY RANGE ((avg(a) OVER (PARTITION BY b)));`,
+		Expected: `CREATE TABLE "partitioned" (a int, b int) PARTITION BY RANGE ((avg("a") OVER (PARTITION BY "b")))`,
+	})
+}
+
+func Test_CreateStmt_PartitionOf(t *testing.T) {
+	DoTest(t, DeparseTest{
+		Query:    `CREATE TABLE measurement_y2006m02 PARTITION OF measurement FOR VALUES FROM ('2006-02-01') TO ('2006-03-01');`,
+		Expected: `CREATE TABLE "measurement_y2006m02" PARTITION OF "measurement" FOR VALUES FROM ('2006-02-01') TO ('2006-03-01')`,
+	})
+	DoTest(t, DeparseTest{
+		Query:    `CREATE TABLE part_a PARTITION OF list_parted (a NOT NULL, CONSTRAINT check_b CHECK (b > 0)) FOR VALUES IN ('a', 'b');`,
+		Expected: `CREATE TABLE "part_a" PARTITION OF "list_parted" (a WITH OPTIONS NOT NULL, CONSTRAINT check_b CHECK ("b" > 0)) FOR VALUES IN ('a', 'b')`,
+	})
+	DoTest(t, DeparseTest{
+		Query:    `CREATE TABLE part_low PARTITION OF range_parted FOR VALUES FROM (MINVALUE, 0) TO (10, MAXVALUE) PARTITION BY LIST (b);`,
+		Expected: `CREATE TABLE "part_low" PARTITION OF "range_parted" FOR VALUES FROM (MINVALUE, 0) TO (10, MAXVALUE) PARTITION BY LIST ("b")`,
+	})
+}
+
+func Test_CreateStmt_Options(t *testing.T) {
+	DoTest(t, DeparseTest{
+		Query:    `CREATE TEMP TABLE t (a int) WITH (fillfactor=70) ON COMMIT DELETE ROWS;`,
+		Expected: `CREATE TEMPORARY TABLE "t" (a int) WITH (fillfactor=70) ON COMMIT DELETE ROWS`,
+	})
+	DoTest(t, DeparseTest{
+		Query:    `CREATE TABLE persons OF person_type (name WITH OPTIONS PRIMARY KEY);`,
+		Expected: `CREATE TABLE "persons" OF person_type (name WITH OPTIONS PRIMARY KEY)`,
+	})
+}
+
+func Test_CreateStmt_Identity(t *testing.T) {
+	DoTest(t, DeparseTest{
+		Query:    `CREATE TABLE itest1 (a int GENERATED BY DEFAULT AS IDENTITY (START WITH 7 INCREMENT BY 5), b text, c bigint GENERATED ALWAYS AS IDENTITY);`,
+		Expected: `CREATE TABLE "itest1" (a int GENERATED BY DEFAULT AS IDENTITY (START WITH 7 INCREMENT BY 5), b text, c bigint GENERATED ALWAYS AS IDENTITY)`,
+	})
+}
@@ -2,6 +2,33 @@
 
 package pg_query
 
+import (
+	"strings"
+
+	"github.com/juju/errors"
+)
+
 func (node CreateSubscriptionStmt) Deparse(ctx Context) (*string, error) {
-	panic("Not Implemented")
+	if node.Subname == nil || node.Conninfo == nil {
+		return nil, errors.New("subscription must have a name and a connection")
+	}
+
+	out := []string{"CREATE SUBSCRIPTION", quoteIdentifier(*node.Subname), "CONNECTION", quoteLiteral(*node.Conninfo)}
+
+	if publications, err := node.Publication.DeparseList(Context_None); err != nil {
+		return nil, err
+	} else {
+		out = append(out, "PUBLICATION", strings.Join(publications, ", "))
+	}
+
+	if node.Options.Items != nil && len(node.Options.Items) > 0 {
+		if str, err := deparseDefinition(node.Options); err != nil {
+			return nil, err
+		} else {
+			out = append(out, "WITH", *str)
+		}
+	}
+
+	result := strings.Join(out, " ")
+	return &result, nil
 }
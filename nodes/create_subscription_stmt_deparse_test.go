@@ -0,0 +1,98 @@
+/*
+ * Copyright (c) 2019 Ready Stock
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+package pg_query
+
+import (
+	"testing"
+)
+
+func Test_CreateSubscriptionStmt(t *testing.T) {
+	DoTest(t, DeparseTest{
+		Query:    `CREATE SUBSCRIPTION testsub CONNECTION 'dbname=doesnotexist password=it''s' PUBLICATION testpub, testpub2 WITH (connect = false, slot_name = NONE, enabled = false);`,
+		Expected: `CREATE SUBSCRIPTION testsub CONNECTION 'dbname=doesnotexist password=it''s' PUBLICATION "testpub", "testpub2" WITH (connect = 'false', slot_name = 'none', enabled = 'false')`,
+	})
+}
+
+func Test_AlterSubscriptionStmt_Connection(t *testing.T) {
+	DoTest(t, DeparseTest{
+		Query:    `ALTER SUBSCRIPTION testsub CONNECTION 'dbname=doesnotexist2';`,
+		Expected: `ALTER SUBSCRIPTION testsub CONNECTION 'dbname=doesnotexist2'`,
+	})
+}
+
+func Test_AlterSubscriptionStmt_SetPublication(t *testing.T) {
+	DoTest(t, DeparseTest{
+		Query:    `ALTER SUBSCRIPTION testsub SET PUBLICATION testpub2, testpub3 WITH (refresh = false);`,
+		Expected: `ALTER SUBSCRIPTION testsub SET PUBLICATION "testpub2", "testpub3" WITH (refresh = 'false')`,
+	})
+}
+
+func Test_AlterSubscriptionStmt_Refresh(t *testing.T) {
+	DoTest(t, DeparseTest{
+		Query:    `ALTER SUBSCRIPTION testsub REFRESH PUBLICATION WITH (copy_data = false);`,
+		Expected: `ALTER SUBSCRIPTION testsub REFRESH PUBLICATION WITH (copy_data = 'false')`,
+	})
+}
+
+func Test_AlterSubscriptionStmt_Options(t *testing.T) {
+	DoTest(t, DeparseTest{
+		Query:    `ALTER SUBSCRIPTION testsub SET (slot_name = 'newname', synchronous_commit = local);`,
+		Expected: `ALTER SUBSCRIPTION testsub SET (slot_name = 'newname', synchronous_commit = local)`,
+	})
+}
+
+func Test_AlterSubscriptionStmt_Enable(t *testing.T) {
+	DoTest(t, DeparseTest{
+		Query:    `ALTER SUBSCRIPTION testsub ENABLE;`,
+		Expected: `ALTER SUBSCRIPTION testsub ENABLE`,
+	})
+}
+
+func Test_AlterSubscriptionStmt_Disable(t *testing.T) {
+	DoTest(t, DeparseTest{
+		Query:    `ALTER SUBSCRIPTION testsub DISABLE;`,
+		Expected: `ALTER SUBSCRIPTION testsub DISABLE`,
+	})
+}
+
+func Test_DropSubscriptionStmt(t *testing.T) {
+	DoTest(t, DeparseTest{
+		Query:    `DROP SUBSCRIPTION IF EXISTS testsub CASCADE;`,
+		Expected: `DROP SUBSCRIPTION IF EXISTS testsub CASCADE`,
+	})
+}
+
+func Test_CreateSubscriptionStmt_QuotedName(t *testing.T) {
+	DoTest(t, DeparseTest{
+		Query:    `CREATE SUBSCRIPTION "Sub" CONNECTION 'dbname=foo' PUBLICATION "Pub"`,
+		Expected: `CREATE SUBSCRIPTION "Sub" CONNECTION 'dbname=foo' PUBLICATION "Pub"`,
+	})
+}
+
+func Test_AlterSubscriptionStmt_QuotedName(t *testing.T) {
+	DoTest(t, DeparseTest{
+		Query:    `ALTER SUBSCRIPTION "Sub" REFRESH PUBLICATION`,
+		Expected: `ALTER SUBSCRIPTION "Sub" REFRESH PUBLICATION`,
+	})
+}
+
+func Test_DropSubscriptionStmt_QuotedName(t *testing.T) {
+	DoTest(t, DeparseTest{
+		Query:    `DROP SUBSCRIPTION IF EXISTS "Sub"`,
+		Expected: `DROP SUBSCRIPTION IF EXISTS "Sub"`,
+	})
+}
@@ -2,6 +2,51 @@
 
 package pg_query
 
+import (
+	"strings"
+
+	"github.com/juju/errors"
+)
+
 func (node CreateTableAsStmt) Deparse(ctx Context) (*string, error) {
-	panic("Not Implemented")
+	out := []string{"CREATE"}
+	if node.Into == nil || node.Into.Rel == nil {
+		return nil, errors.New("create table as statement must have a target relation")
+	}
+
+	if persistence := node.Into.Rel.relPersistence(); persistence != nil {
+		out = append(out, *persistence)
+	}
+
+	switch node.Relkind {
+	case OBJECT_TABLE:
+		out = append(out, "TABLE")
+	case OBJECT_MATVIEW:
+		out = append(out, "MATERIALIZED VIEW")
+	default:
+		return nil, errors.Errorf("cannot deparse create table as for relkind (%d)", node.Relkind)
+	}
+
+	if node.IfNotExists {
+		out = append(out, "IF NOT EXISTS")
+	}
+
+	if str, err := node.Into.Deparse(Context_None); err != nil {
+		return nil, err
+	} else {
+		out = append(out, *str)
+	}
+
+	if str, err := deparseNode(node.Query, Context_None); err != nil {
+		return nil, err
+	} else {
+		out = append(out, "AS", *str)
+	}
+
+	if node.Into.SkipData {
+		out = append(out, "WITH NO DATA")
+	}
+
+	result := strings.Join(out, " ")
+	return &result, nil
 }
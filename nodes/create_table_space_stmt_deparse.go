@@ -2,6 +2,40 @@
 
 package pg_query
 
+import (
+	"strings"
+
+	"github.com/juju/errors"
+)
+
 func (node CreateTableSpaceStmt) Deparse(ctx Context) (*string, error) {
-	panic("Not Implemented")
+	out := []string{"CREATE TABLESPACE"}
+	if node.Tablespacename == nil {
+		return nil, errors.New("tablespace name cannot be null in create tablespace")
+	}
+	out = append(out, quoteIdentifier(*node.Tablespacename))
+
+	if node.Owner != nil {
+		if str, err := deparseNode(*node.Owner, Context_None); err != nil {
+			return nil, err
+		} else {
+			out = append(out, "OWNER", *str)
+		}
+	}
+
+	if node.Location == nil {
+		return nil, errors.New("location cannot be null in create tablespace")
+	}
+	out = append(out, "LOCATION", quoteLiteral(*node.Location))
+
+	if node.Options.Items != nil && len(node.Options.Items) > 0 {
+		if str, err := deparseRelOptions(node.Options); err != nil {
+			return nil, err
+		} else {
+			out = append(out, "WITH", *str)
+		}
+	}
+
+	result := strings.Join(out, " ")
+	return &result, nil
 }
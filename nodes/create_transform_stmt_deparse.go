@@ -2,6 +2,49 @@
 
 package pg_query
 
+import (
+	"fmt"
+	"strings"
+
+	"github.com/juju/errors"
+)
+
 func (node CreateTransformStmt) Deparse(ctx Context) (*string, error) {
-	panic("Not Implemented")
+	out := []string{"CREATE"}
+	if node.Replace {
+		out = append(out, "OR REPLACE")
+	}
+	out = append(out, "TRANSFORM")
+
+	if node.TypeName == nil || node.Lang == nil {
+		return nil, errors.New("type and language cannot be null in create transform")
+	}
+
+	if str, err := deparseNode(*node.TypeName, Context_None); err != nil {
+		return nil, err
+	} else {
+		out = append(out, "FOR", *str, "LANGUAGE", *node.Lang)
+	}
+
+	// At least one of the functions has to be given.
+	functions := make([]string, 0)
+	if node.Fromsql != nil {
+		if str, err := deparseNode(*node.Fromsql, Context_None); err != nil {
+			return nil, err
+		} else {
+			functions = append(functions, fmt.Sprintf("FROM SQL WITH FUNCTION %s", *str))
+		}
+	}
+
+	if node.Tosql != nil {
+		if str, err := deparseNode(*node.Tosql, Context_None); err != nil {
+			return nil, err
+		} else {
+			functions = append(functions, fmt.Sprintf("TO SQL WITH FUNCTION %s", *str))
+		}
+	}
+	out = append(out, fmt.Sprintf("(%s)", strings.Join(functions, ", ")))
+
+	result := strings.Join(out, " ")
+	return &result, nil
 }
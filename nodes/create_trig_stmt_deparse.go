@@ -2,6 +2,138 @@
 
 package pg_query
 
+import (
+	"fmt"
+	"strings"
+
+	"github.com/juju/errors"
+)
+
 func (node CreateTrigStmt) Deparse(ctx Context) (*string, error) {
-	panic("Not Implemented")
+	out := []string{"CREATE"}
+	if node.Isconstraint {
+		out = append(out, "CONSTRAINT")
+	}
+
+	if node.Trigname == nil {
+		return nil, errors.New("trigger must have a name")
+	}
+	out = append(out, "TRIGGER", quoteIdentifier(*node.Trigname))
+
+	switch node.Timing {
+	case TRIGGER_TYPE_BEFORE:
+		out = append(out, "BEFORE")
+	case TRIGGER_TYPE_AFTER:
+		out = append(out, "AFTER")
+	case TRIGGER_TYPE_INSTEAD:
+		out = append(out, "INSTEAD OF")
+	default:
+		return nil, errors.Errorf("cannot deparse trigger timing (%d)", node.Timing)
+	}
+
+	events := make([]string, 0)
+	if node.Events&TRIGGER_TYPE_INSERT != 0 {
+		events = append(events, "INSERT")
+	}
+
+	if node.Events&TRIGGER_TYPE_UPDATE != 0 {
+		if node.Columns.Items != nil && len(node.Columns.Items) > 0 {
+			if columns, err := node.Columns.DeparseList(Context_FuncCall); err != nil {
+				return nil, err
+			} else {
+				events = append(events, fmt.Sprintf("UPDATE OF %s", strings.Join(columns, ", ")))
+			}
+		} else {
+			events = append(events, "UPDATE")
+		}
+	}
+
+	if node.Events&TRIGGER_TYPE_DELETE != 0 {
+		events = append(events, "DELETE")
+	}
+
+	if node.Events&TRIGGER_TYPE_TRUNCATE != 0 {
+		events = append(events, "TRUNCATE")
+	}
+
+	if len(events) == 0 {
+		return nil, errors.Errorf("cannot deparse trigger events (%d)", node.Events)
+	}
+	out = append(out, strings.Join(events, " OR "))
+
+	if node.Relation == nil {
+		return nil, errors.New("trigger must have a relation")
+	}
+
+	if str, err := node.Relation.Deparse(Context_None); err != nil {
+		return nil, err
+	} else {
+		out = append(out, "ON", *str)
+	}
+
+	if node.Constrrel != nil {
+		if str, err := node.Constrrel.Deparse(Context_None); err != nil {
+			return nil, err
+		} else {
+			out = append(out, "FROM", *str)
+		}
+	}
+
+	if node.Deferrable {
+		out = append(out, "DEFERRABLE")
+	}
+
+	if node.Initdeferred {
+		out = append(out, "INITIALLY DEFERRED")
+	}
+
+	if node.TransitionRels.Items != nil && len(node.TransitionRels.Items) > 0 {
+		if transitions, err := node.TransitionRels.DeparseList(Context_None); err != nil {
+			return nil, err
+		} else {
+			out = append(out, "REFERENCING", strings.Join(transitions, " "))
+		}
+	}
+
+	if node.Row {
+		out = append(out, "FOR EACH ROW")
+	}
+
+	if node.WhenClause != nil {
+		if str, err := deparseNode(node.WhenClause, Context_None); err != nil {
+			return nil, err
+		} else {
+			out = append(out, fmt.Sprintf("WHEN (%s)", *str))
+		}
+	}
+
+	if str, err := deparseTriggerFunction(node.Funcname, node.Args); err != nil {
+		return nil, err
+	} else {
+		out = append(out, *str)
+	}
+
+	result := strings.Join(out, " ")
+	return &result, nil
+}
+
+// deparseTriggerFunction returns the EXECUTE PROCEDURE clause of a trigger,
+// the arguments are always passed to the function as string literals.
+func deparseTriggerFunction(funcname List, args List) (*string, error) {
+	names, err := funcname.DeparseList(Context_FuncCall)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(names) == 0 {
+		return nil, errors.New("trigger must have a function")
+	}
+
+	arguments, err := args.DeparseList(Context_AConst)
+	if err != nil {
+		return nil, err
+	}
+
+	result := fmt.Sprintf("EXECUTE PROCEDURE %s(%s)", strings.Join(names, "."), strings.Join(arguments, ", "))
+	return &result, nil
 }
@@ -0,0 +1,123 @@
+/*
+ * Copyright (c) 2019 Ready Stock
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+package pg_query
+
+import (
+	"testing"
+)
+
+func Test_CreateTrigStmt_Timing(t *testing.T) {
+	DoTest(t, DeparseTest{
+		Query:    `CREATE TRIGGER check_update BEFORE UPDATE OF balance ON accounts FOR EACH ROW EXECUTE PROCEDURE check_account_update();`,
+		Expected: `CREATE TRIGGER check_update BEFORE UPDATE OF balance ON "accounts" FOR EACH ROW EXECUTE PROCEDURE check_account_update()`,
+	})
+	DoTest(t, DeparseTest{
+		Query:    `CREATE TRIGGER log_changes AFTER INSERT OR UPDATE OR DELETE ON accounts EXECUTE PROCEDURE log_account_update('accounts', 1);`,
+		Expected: `CREATE TRIGGER log_changes AFTER INSERT OR UPDATE OR DELETE ON "accounts" EXECUTE PROCEDURE log_account_update('accounts', '1')`,
+	})
+	DoTest(t, DeparseTest{
+		Query:    `CREATE TRIGGER view_insert INSTEAD OF INSERT ON my_view FOR EACH ROW EXECUTE PROCEDURE public.view_insert_row();`,
+		Expected: `CREATE TRIGGER view_insert INSTEAD OF INSERT ON "my_view" FOR EACH ROW EXECUTE PROCEDURE public.view_insert_row()`,
+	})
+	DoTest(t, DeparseTest{
+		Query:    `CREATE TRIGGER truncate_log AFTER TRUNCATE ON accounts FOR EACH STATEMENT EXECUTE PROCEDURE log_truncate();`,
+		Expected: `CREATE TRIGGER truncate_log AFTER TRUNCATE ON "accounts" EXECUTE PROCEDURE log_truncate()`,
+	})
+}
+
+func Test_CreateTrigStmt_WhenAndReferencing(t *testing.T) {
+	DoTest(t, DeparseTest{
+		Query:    `CREATE TRIGGER check_update BEFORE UPDATE ON accounts FOR EACH ROW WHEN (OLD.balance IS DISTINCT FROM NEW.balance) EXECUTE PROCEDURE check_account_update();`,
+		Expected: `CREATE TRIGGER check_update BEFORE UPDATE ON "accounts" FOR EACH ROW WHEN ("old"."balance" IS DISTINCT FROM "new"."balance") EXECUTE PROCEDURE check_account_update()`,
+	})
+	DoTest(t, DeparseTest{
+		Query:    `CREATE TRIGGER transition_log AFTER UPDATE ON accounts REFERENCING OLD TABLE AS old_rows NEW TABLE AS new_rows FOR EACH STATEMENT EXECUTE PROCEDURE log_rows();`,
+		Expected: `CREATE TRIGGER transition_log AFTER UPDATE ON "accounts" REFERENCING OLD TABLE AS old_rows NEW TABLE AS new_rows EXECUTE PROCEDURE log_rows()`,
+	})
+}
+
+func Test_CreateTrigStmt_Constraint(t *testing.T) {
+	DoTest(t, DeparseTest{
+		Query:    `CREATE CONSTRAINT TRIGGER check_fk AFTER INSERT OR UPDATE ON orders FROM customers DEFERRABLE INITIALLY DEFERRED FOR EACH ROW EXECUTE PROCEDURE check_customer();`,
+		Expected: `CREATE CONSTRAINT TRIGGER check_fk AFTER INSERT OR UPDATE ON "orders" FROM "customers" DEFERRABLE INITIALLY DEFERRED FOR EACH ROW EXECUTE PROCEDURE check_customer()`,
+	})
+}
+
+func Test_CreateEventTrigStmt(t *testing.T) {
+	DoTest(t, DeparseTest{
+		Query:    `CREATE EVENT TRIGGER regress_event_trigger ON ddl_command_start EXECUTE PROCEDURE test_event_trigger();`,
+		Expected: `CREATE EVENT TRIGGER regress_event_trigger ON ddl_command_start EXECUTE PROCEDURE test_event_trigger()`,
+	})
+	DoTest(t, DeparseTest{
+		Query:    `CREATE EVENT TRIGGER regress_event_trigger2 ON ddl_command_start WHEN tag IN ('create table', 'CREATE FUNCTION') EXECUTE PROCEDURE test_event_trigger();`,
+		Expected: `CREATE EVENT TRIGGER regress_event_trigger2 ON ddl_command_start WHEN tag IN ('create table', 'CREATE FUNCTION') EXECUTE PROCEDURE test_event_trigger()`,
+	})
+}
+
+func Test_AlterEventTrigStmt(t *testing.T) {
+	DoTest(t, DeparseTest{
+		Query:    `ALTER EVENT TRIGGER regress_event_trigger ENABLE REPLICA;`,
+		Expected: `ALTER EVENT TRIGGER regress_event_trigger ENABLE REPLICA`,
+	})
+	DoTest(t, DeparseTest{
+		Query:    `ALTER EVENT TRIGGER regress_event_trigger DISABLE;`,
+		Expected: `ALTER EVENT TRIGGER regress_event_trigger DISABLE`,
+	})
+}
+
+func Test_RuleStmt(t *testing.T) {
+	DoTest(t, DeparseTest{
+		Query:    `CREATE RULE rtest_nothn_r1 AS ON INSERT TO rtest_nothn1 WHERE new.a >= 10 AND new.a < 20 DO INSTEAD NOTHING;`,
+		Expected: `CREATE RULE rtest_nothn_r1 AS ON INSERT TO "rtest_nothn1" WHERE "new"."a" >= 10 AND "new"."a" < 20 DO INSTEAD NOTHING`,
+	})
+	DoTest(t, DeparseTest{
+		Query:    `CREATE OR REPLACE RULE log_delete AS ON DELETE TO accounts DO INSERT INTO accounts_log VALUES (old.id);`,
+		Expected: `CREATE OR REPLACE RULE log_delete AS ON DELETE TO "accounts" DO INSERT INTO "accounts_log" VALUES ("old"."id")`,
+	})
+	DoTest(t, DeparseTest{
+		Query:    `CREATE RULE archive AS ON UPDATE TO accounts DO INSTEAD (INSERT INTO accounts_log VALUES (old.id); UPDATE accounts_archive SET id = new.id);`,
+		Expected: `CREATE RULE archive AS ON UPDATE TO "accounts" DO INSTEAD (INSERT INTO "accounts_log" VALUES ("old"."id"); UPDATE "accounts_archive" SET id = "new"."id")`,
+	})
+}
+
+func Test_CreateTrigStmt_QuotedNames(t *testing.T) {
+	DoTest(t, DeparseTest{
+		Query:    `CREATE TRIGGER "Trg" AFTER INSERT ON t REFERENCING NEW TABLE AS "New Rows" FOR EACH ROW EXECUTE PROCEDURE f()`,
+		Expected: `CREATE TRIGGER "Trg" AFTER INSERT ON "t" REFERENCING NEW TABLE AS "New Rows" FOR EACH ROW EXECUTE PROCEDURE f()`,
+	})
+}
+
+func Test_CreateEventTrigStmt_QuotedName(t *testing.T) {
+	DoTest(t, DeparseTest{
+		Query:    `CREATE EVENT TRIGGER "Evt" ON ddl_command_start WHEN tag IN ('CREATE TABLE') EXECUTE PROCEDURE f()`,
+		Expected: `CREATE EVENT TRIGGER "Evt" ON ddl_command_start WHEN tag IN ('CREATE TABLE') EXECUTE PROCEDURE f()`,
+	})
+}
+
+func Test_AlterEventTrigStmt_QuotedName(t *testing.T) {
+	DoTest(t, DeparseTest{
+		Query:    `ALTER EVENT TRIGGER "Evt" DISABLE`,
+		Expected: `ALTER EVENT TRIGGER "Evt" DISABLE`,
+	})
+}
+
+func Test_RuleStmt_QuotedName(t *testing.T) {
+	DoTest(t, DeparseTest{
+		Query:    `CREATE RULE "_RETURN" AS ON SELECT TO t DO INSTEAD SELECT 1`,
+		Expected: `CREATE RULE "_RETURN" AS ON SELECT TO "t" DO INSTEAD SELECT 1`,
+	})
+}
@@ -2,6 +2,55 @@
 
 package pg_query
 
+import (
+	"fmt"
+	"strings"
+
+	"github.com/juju/errors"
+)
+
 func (node CreatedbStmt) Deparse(ctx Context) (*string, error) {
-	panic("Not Implemented")
+	out := []string{"CREATE DATABASE"}
+	if node.Dbname == nil {
+		return nil, errors.New("database name cannot be null in create database")
+	}
+	out = append(out, quoteIdentifier(*node.Dbname))
+
+	if node.Options.Items != nil && len(node.Options.Items) > 0 {
+		if options, err := deparseCreatedbOptions(node.Options); err != nil {
+			return nil, err
+		} else {
+			out = append(out, "WITH", *options)
+		}
+	}
+
+	result := strings.Join(out, " ")
+	return &result, nil
+}
+
+// deparseCreatedbOptions returns the options of CREATE DATABASE or ALTER
+// DATABASE, an option without a value resets it to its default.
+func deparseCreatedbOptions(options List) (*string, error) {
+	out := make([]string, len(options.Items))
+	for i, item := range options.Items {
+		option, ok := item.(DefElem)
+		if !ok || option.Defname == nil {
+			return nil, errors.Errorf("cannot deparse database option of type %T", item)
+		}
+
+		name := strings.ToUpper(*option.Defname)
+		if *option.Defname == "connection_limit" {
+			name = "CONNECTION LIMIT"
+		}
+
+		if option.Arg == nil {
+			out[i] = fmt.Sprintf("%s = DEFAULT", name)
+		} else if str, err := option.deparseArg(); err != nil {
+			return nil, err
+		} else {
+			out[i] = fmt.Sprintf("%s = %s", name, *str)
+		}
+	}
+	result := strings.Join(out, " ")
+	return &result, nil
 }
@@ -2,6 +2,17 @@
 
 package pg_query
 
+import (
+	"fmt"
+
+	"github.com/juju/errors"
+)
+
 func (node CurrentOfExpr) Deparse(ctx Context) (*string, error) {
-	panic("Not Implemented")
+	if node.CursorName == nil {
+		return nil, errors.New("current of expression must have a cursor name")
+	}
+
+	result := fmt.Sprintf("CURRENT OF %s", quoteIdentifier(*node.CursorName))
+	return &result, nil
 }
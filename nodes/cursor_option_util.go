@@ -0,0 +1,38 @@
+/*
+ * Copyright (c) 2019 Ready Stock
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+package pg_query
+
+/* ----------------------
+ *		Declare Cursor Statement
+ *
+ * The "query" field is initially a raw parse tree, and is converted to a
+ * Query node during parse analysis.  Note that rewriting and planning
+ * of the query are always postponed until execution.
+ * ----------------------
+ */
+const (
+	CURSOR_OPT_BINARY      = 0x0001 /* BINARY */
+	CURSOR_OPT_SCROLL      = 0x0002 /* SCROLL explicitly given */
+	CURSOR_OPT_NO_SCROLL   = 0x0004 /* NO SCROLL explicitly given */
+	CURSOR_OPT_INSENSITIVE = 0x0008 /* INSENSITIVE */
+	CURSOR_OPT_HOLD        = 0x0010 /* WITH HOLD */
+	/* these planner-control flags do not correspond to any SQL grammar: */
+	CURSOR_OPT_FAST_PLAN    = 0x0020 /* prefer fast-start plan */
+	CURSOR_OPT_GENERIC_PLAN = 0x0040 /* force use of generic plan */
+	CURSOR_OPT_CUSTOM_PLAN  = 0x0080 /* force use of custom plan */
+	CURSOR_OPT_PARALLEL_OK  = 0x0100 /* parallel mode OK */
+)
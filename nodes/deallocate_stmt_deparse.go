@@ -2,6 +2,20 @@
 
 package pg_query
 
+import (
+	"strings"
+)
+
 func (node DeallocateStmt) Deparse(ctx Context) (*string, error) {
-	panic("Not Implemented")
+	out := []string{"DEALLOCATE"}
+
+	// Without a name all prepared statements are removed.
+	if node.Name == nil {
+		out = append(out, "ALL")
+	} else {
+		out = append(out, quoteIdentifier(*node.Name))
+	}
+
+	result := strings.Join(out, " ")
+	return &result, nil
 }
@@ -2,6 +2,44 @@
 
 package pg_query
 
+import (
+	"strings"
+
+	"github.com/juju/errors"
+)
+
 func (node DeclareCursorStmt) Deparse(ctx Context) (*string, error) {
-	panic("Not Implemented")
+	if node.Portalname == nil {
+		return nil, errors.New("cursor must have a name")
+	}
+
+	out := []string{"DECLARE", quoteIdentifier(*node.Portalname)}
+	if node.Options&CURSOR_OPT_BINARY != 0 {
+		out = append(out, "BINARY")
+	}
+
+	if node.Options&CURSOR_OPT_INSENSITIVE != 0 {
+		out = append(out, "INSENSITIVE")
+	}
+
+	if node.Options&CURSOR_OPT_NO_SCROLL != 0 {
+		out = append(out, "NO SCROLL")
+	} else if node.Options&CURSOR_OPT_SCROLL != 0 {
+		out = append(out, "SCROLL")
+	}
+
+	out = append(out, "CURSOR")
+
+	if node.Options&CURSOR_OPT_HOLD != 0 {
+		out = append(out, "WITH HOLD")
+	}
+
+	if str, err := deparseNode(node.Query, Context_None); err != nil {
+		return nil, err
+	} else {
+		out = append(out, "FOR", *str)
+	}
+
+	result := strings.Join(out, " ")
+	return &result, nil
 }
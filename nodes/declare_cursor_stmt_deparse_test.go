@@ -0,0 +1,140 @@
+/*
+ * Copyright (c) 2019 Ready Stock
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+package pg_query
+
+import (
+	"testing"
+)
+
+func Test_DeclareCursorStmt(t *testing.T) {
+	DoTest(t, DeparseTest{
+		Query:    `DECLARE foo1 SCROLL CURSOR FOR SELECT * FROM tenk1 ORDER BY unique2;`,
+		Expected: `DECLARE foo1 SCROLL CURSOR FOR SELECT * FROM "tenk1" ORDER BY "unique2"`,
+	})
+}
+
+func Test_DeclareCursorStmt_Options(t *testing.T) {
+	DoTest(t, DeparseTest{
+		Query:    `DECLARE foo2 BINARY INSENSITIVE NO SCROLL CURSOR WITH HOLD FOR SELECT * FROM tenk2;`,
+		Expected: `DECLARE foo2 BINARY INSENSITIVE NO SCROLL CURSOR WITH HOLD FOR SELECT * FROM "tenk2"`,
+	})
+}
+
+func Test_FetchStmt_Next(t *testing.T) {
+	DoTest(t, DeparseTest{
+		Query:    `FETCH NEXT IN foo1;`,
+		Expected: `FETCH NEXT FROM foo1`,
+	})
+}
+
+func Test_FetchStmt_Count(t *testing.T) {
+	DoTest(t, DeparseTest{
+		Query:    `FETCH 3 foo1;`,
+		Expected: `FETCH FORWARD 3 FROM foo1`,
+	})
+}
+
+func Test_FetchStmt_All(t *testing.T) {
+	DoTest(t, DeparseTest{
+		Query:    `FETCH ALL FROM foo1;`,
+		Expected: `FETCH FORWARD ALL FROM foo1`,
+	})
+}
+
+func Test_FetchStmt_Backward(t *testing.T) {
+	DoTest(t, DeparseTest{
+		Query:    `FETCH BACKWARD ALL IN foo1;`,
+		Expected: `FETCH BACKWARD ALL FROM foo1`,
+	})
+}
+
+func Test_FetchStmt_Prior(t *testing.T) {
+	DoTest(t, DeparseTest{
+		Query:    `FETCH PRIOR FROM foo1;`,
+		Expected: `FETCH PRIOR FROM foo1`,
+	})
+}
+
+func Test_FetchStmt_First(t *testing.T) {
+	DoTest(t, DeparseTest{
+		Query:    `FETCH FIRST foo1;`,
+		Expected: `FETCH FIRST FROM foo1`,
+	})
+}
+
+func Test_FetchStmt_Last(t *testing.T) {
+	DoTest(t, DeparseTest{
+		Query:    `MOVE LAST IN foo1;`,
+		Expected: `MOVE LAST FROM foo1`,
+	})
+}
+
+func Test_FetchStmt_Absolute(t *testing.T) {
+	DoTest(t, DeparseTest{
+		Query:    `FETCH ABSOLUTE -4 FROM foo1;`,
+		Expected: `FETCH ABSOLUTE -4 FROM foo1`,
+	})
+}
+
+func Test_FetchStmt_Relative(t *testing.T) {
+	DoTest(t, DeparseTest{
+		Query:    `MOVE RELATIVE 2 IN foo1;`,
+		Expected: `MOVE RELATIVE 2 FROM foo1`,
+	})
+}
+
+func Test_ClosePortalStmt(t *testing.T) {
+	DoTest(t, DeparseTest{
+		Query:    `CLOSE foo1;`,
+		Expected: `CLOSE foo1`,
+	})
+}
+
+func Test_ClosePortalStmt_All(t *testing.T) {
+	DoTest(t, DeparseTest{
+		Query:    `CLOSE ALL;`,
+		Expected: `CLOSE ALL`,
+	})
+}
+
+func Test_DeclareCursorStmt_QuotedName(t *testing.T) {
+	DoTest(t, DeparseTest{
+		Query:    `DECLARE "C" CURSOR FOR SELECT 1`,
+		Expected: `DECLARE "C" CURSOR FOR SELECT 1`,
+	})
+}
+
+func Test_FetchStmt_QuotedName(t *testing.T) {
+	DoTest(t, DeparseTest{
+		Query:    `FETCH NEXT FROM "C"`,
+		Expected: `FETCH NEXT FROM "C"`,
+	})
+}
+
+func Test_MoveStmt_QuotedName(t *testing.T) {
+	DoTest(t, DeparseTest{
+		Query:    `MOVE BACKWARD ALL FROM "select"`,
+		Expected: `MOVE BACKWARD ALL FROM "select"`,
+	})
+}
+
+func Test_ClosePortalStmt_QuotedName(t *testing.T) {
+	DoTest(t, DeparseTest{
+		Query:    `CLOSE "C"`,
+		Expected: `CLOSE "C"`,
+	})
+}
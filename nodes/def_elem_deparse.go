@@ -4,13 +4,196 @@ package pg_query
 
 import (
 	"fmt"
+	"github.com/juju/errors"
+	"strings"
+)
+
+var (
+	defElemActions = map[DefElemAction]string{
+		DEFELEM_SET:  "SET",
+		DEFELEM_ADD:  "ADD",
+		DEFELEM_DROP: "DROP",
+	}
 )
 
 func (node DefElem) Deparse(ctx Context) (*string, error) {
-	if arg, err := node.Arg.Deparse(Context_AConst); err != nil {
+	if node.Defname == nil {
+		return nil, errors.New("definition must have a name")
+	}
+
+	out := make([]string, 0)
+	if action, ok := defElemActions[node.Defaction]; ok {
+		out = append(out, action)
+	}
+
+	out = append(out, quoteLabel(*node.Defname))
+
+	// Options like COPY's HEADER can be specified without any argument at all.
+	if node.Arg != nil {
+		if str, err := node.deparseArg(); err != nil {
+			return nil, err
+		} else {
+			out = append(out, *str)
+		}
+	}
+
+	result := strings.Join(out, " ")
+	return &result, nil
+}
+
+func (node DefElem) deparseArg() (*string, error) {
+	switch arg := node.Arg.(type) {
+	case List:
+		if args, err := arg.DeparseList(Context_AConst); err != nil {
+			return nil, err
+		} else {
+			result := fmt.Sprintf("(%s)", strings.Join(args, ", "))
+			return &result, nil
+		}
+	default:
+		return arg.Deparse(Context_AConst)
+	}
+}
+
+// deparseRelOption renders the definition as a storage parameter, which unlike
+// most other options uses the `name=value` form and can be namespaced.
+func (node DefElem) deparseRelOption() (*string, error) {
+	if node.Defname == nil {
+		return nil, errors.New("storage parameter must have a name")
+	}
+
+	name := *node.Defname
+	if node.Defnamespace != nil {
+		name = fmt.Sprintf("%s.%s", *node.Defnamespace, name)
+	}
+
+	if node.Arg == nil {
+		return &name, nil
+	}
+
+	if str, err := node.deparseArg(); err != nil {
 		return nil, err
 	} else {
-		result := fmt.Sprintf("%s %s", *node.Defname, *arg)
+		result := fmt.Sprintf("%s=%s", name, *str)
 		return &result, nil
 	}
 }
+
+// deparseSeqOption renders the definition as one of the options that can be
+// provided to CREATE SEQUENCE, ALTER SEQUENCE or an identity column.
+func (node DefElem) deparseSeqOption() (*string, error) {
+	if node.Defname == nil {
+		return nil, errors.New("sequence option must have a name")
+	}
+
+	out := make([]string, 0)
+	switch *node.Defname {
+	case "as":
+		out = append(out, "AS")
+	case "cache":
+		out = append(out, "CACHE")
+	case "cycle":
+		if arg, ok := node.Arg.(Integer); ok && arg.Ival == 0 {
+			result := "NO CYCLE"
+			return &result, nil
+		}
+		result := "CYCLE"
+		return &result, nil
+	case "increment":
+		out = append(out, "INCREMENT BY")
+	case "maxvalue", "minvalue":
+		if node.Arg == nil {
+			result := fmt.Sprintf("NO %s", strings.ToUpper(*node.Defname))
+			return &result, nil
+		}
+		out = append(out, strings.ToUpper(*node.Defname))
+	case "owned_by":
+		out = append(out, "OWNED BY")
+	case "sequence_name":
+		out = append(out, "SEQUENCE NAME")
+	case "start":
+		out = append(out, "START WITH")
+	case "restart":
+		out = append(out, "RESTART")
+		if node.Arg != nil {
+			out = append(out, "WITH")
+		}
+	default:
+		return nil, errors.Errorf("cannot deparse sequence option: %s", *node.Defname)
+	}
+
+	switch arg := node.Arg.(type) {
+	case nil:
+	case List:
+		// OWNED BY NONE is stored as a regular name, which must remain a keyword
+		// rather than refer to a column called none.
+		if len(arg.Items) == 1 {
+			if name, ok := arg.Items[0].(String); ok && name.Str == "none" {
+				out = append(out, "NONE")
+				break
+			}
+		}
+
+		if names, err := arg.DeparseList(Context_None); err != nil {
+			return nil, err
+		} else {
+			out = append(out, strings.Join(names, "."))
+		}
+	default:
+		if str, err := arg.Deparse(Context_None); err != nil {
+			return nil, err
+		} else {
+			out = append(out, *str)
+		}
+	}
+
+	result := strings.Join(out, " ")
+	return &result, nil
+}
+
+func deparseRelOptions(options List) (*string, error) {
+	out := make([]string, len(options.Items))
+	for i, item := range options.Items {
+		option, ok := item.(DefElem)
+		if !ok {
+			return nil, errors.Errorf("cannot deparse storage parameter of type %T", item)
+		}
+
+		if str, err := option.deparseRelOption(); err != nil {
+			return nil, err
+		} else {
+			out[i] = *str
+		}
+	}
+	result := fmt.Sprintf("(%s)", strings.Join(out, ", "))
+	return &result, nil
+}
+
+// deparseGenericOptions returns the OPTIONS clause that passes options to a
+// foreign-data wrapper, when altering them each option can be added, set or
+// dropped individually.
+func deparseGenericOptions(options List) (*string, error) {
+	if optionList, err := options.DeparseList(Context_None); err != nil {
+		return nil, err
+	} else {
+		result := fmt.Sprintf("OPTIONS (%s)", strings.Join(optionList, ", "))
+		return &result, nil
+	}
+}
+
+func deparseSeqOptions(options List) ([]string, error) {
+	out := make([]string, len(options.Items))
+	for i, item := range options.Items {
+		option, ok := item.(DefElem)
+		if !ok {
+			return nil, errors.Errorf("cannot deparse sequence option of type %T", item)
+		}
+
+		if str, err := option.deparseSeqOption(); err != nil {
+			return nil, err
+		} else {
+			out[i] = *str
+		}
+	}
+	return out, nil
+}
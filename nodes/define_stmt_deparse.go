@@ -2,6 +2,177 @@
 
 package pg_query
 
+import (
+	"fmt"
+	"strings"
+
+	"github.com/juju/errors"
+)
+
+var (
+	defineStmtKinds = map[ObjectType]string{
+		OBJECT_AGGREGATE:       "AGGREGATE",
+		OBJECT_COLLATION:       "COLLATION",
+		OBJECT_OPERATOR:        "OPERATOR",
+		OBJECT_TSCONFIGURATION: "TEXT SEARCH CONFIGURATION",
+		OBJECT_TSDICTIONARY:    "TEXT SEARCH DICTIONARY",
+		OBJECT_TSPARSER:        "TEXT SEARCH PARSER",
+		OBJECT_TSTEMPLATE:      "TEXT SEARCH TEMPLATE",
+		OBJECT_TYPE:            "TYPE",
+	}
+)
+
 func (node DefineStmt) Deparse(ctx Context) (*string, error) {
-	panic("Not Implemented")
+	out := []string{"CREATE"}
+	if kind, ok := defineStmtKinds[node.Kind]; !ok {
+		return nil, errors.Errorf("cannot deparse define statement of kind (%d)", node.Kind)
+	} else {
+		out = append(out, kind)
+	}
+
+	if node.IfNotExists {
+		out = append(out, "IF NOT EXISTS")
+	}
+
+	// Operators are named by their symbol, which must never be quoted.
+	nameCtx := Context_None
+	if node.Kind == OBJECT_OPERATOR {
+		nameCtx = Context_Operator
+	}
+
+	if names, err := node.Defnames.DeparseList(nameCtx); err != nil {
+		return nil, err
+	} else {
+		out = append(out, strings.Join(names, "."))
+	}
+
+	if node.Kind == OBJECT_AGGREGATE && !node.Oldstyle {
+		if str, err := node.deparseAggregateArgs(); err != nil {
+			return nil, err
+		} else {
+			out = append(out, *str)
+		}
+	}
+
+	// A collation can be copied from an existing one instead of being defined.
+	if node.Kind == OBJECT_COLLATION && len(node.Definition.Items) == 1 {
+		if from, ok := node.Definition.Items[0].(DefElem); ok && from.Defname != nil && *from.Defname == "from" {
+			if names, ok := from.Arg.(List); !ok {
+				return nil, errors.New("collation must be copied from a named collation")
+			} else if names, err := names.DeparseList(Context_None); err != nil {
+				return nil, err
+			} else {
+				out = append(out, "FROM", strings.Join(names, "."))
+			}
+			result := strings.Join(out, " ")
+			return &result, nil
+		}
+	}
+
+	// Shell types are created without any definition at all.
+	if node.Definition.Items != nil && len(node.Definition.Items) > 0 {
+		if str, err := deparseDefinition(node.Definition); err != nil {
+			return nil, err
+		} else {
+			out = append(out, *str)
+		}
+	}
+
+	result := strings.Join(out, " ")
+	return &result, nil
+}
+
+// deparseAggregateArgs returns the argument list of an aggregate, the parser
+// stores this as the list of parameters followed by the number of direct
+// arguments of an ordered-set aggregate or -1 for a regular aggregate.
+func (node DefineStmt) deparseAggregateArgs() (*string, error) {
+	if len(node.Args.Items) != 2 {
+		return nil, errors.New("aggregate arguments must be a pair of parameters and direct argument count")
+	}
+
+	params, _ := node.Args.Items[0].(List)
+	if params.Items == nil || len(params.Items) == 0 {
+		result := "(*)"
+		return &result, nil
+	}
+
+	args, err := params.DeparseList(Context_None)
+	if err != nil {
+		return nil, err
+	}
+
+	count, ok := node.Args.Items[1].(Integer)
+	if !ok || count.Ival > int64(len(args)) {
+		return nil, errors.New("aggregate direct argument count must be an integer within the arguments")
+	}
+
+	direct := count.Ival
+	if direct < 0 {
+		result := fmt.Sprintf("(%s)", strings.Join(args, ", "))
+		return &result, nil
+	}
+
+	// When the last direct argument and the aggregated argument are the same
+	// variadic parameter the parser only keeps a single copy of it.
+	aggregated := args[direct:]
+	if int(direct) == len(args) {
+		aggregated = args[direct-1:]
+	}
+
+	out := make([]string, 0)
+	if direct > 0 {
+		out = append(out, strings.Join(args[:direct], ", "))
+	}
+	out = append(out, "ORDER BY", strings.Join(aggregated, ", "))
+
+	result := fmt.Sprintf("(%s)", strings.Join(out, " "))
+	return &result, nil
+}
+
+// deparseDefinition returns the parenthesized list of `name = value` pairs
+// used to define aggregates, operators, base types and similar objects.
+func deparseDefinition(definition List) (*string, error) {
+	out := make([]string, len(definition.Items))
+	for i, item := range definition.Items {
+		elem, ok := item.(DefElem)
+		if !ok {
+			return nil, errors.Errorf("cannot deparse definition of type %T", item)
+		}
+
+		if str, err := deparseDefinitionElem(elem); err != nil {
+			return nil, err
+		} else {
+			out[i] = *str
+		}
+	}
+
+	result := fmt.Sprintf("(%s)", strings.Join(out, ", "))
+	return &result, nil
+}
+
+func deparseDefinitionElem(elem DefElem) (*string, error) {
+	if elem.Defname == nil {
+		return nil, errors.New("definition must have a name")
+	}
+
+	name := quoteLabel(*elem.Defname)
+	var value *string
+	var err error
+	switch arg := elem.Arg.(type) {
+	case nil:
+		return &name, nil
+	case List:
+		// Lists are only used for operator names, like the commutator.
+		value, err = deparseOperatorName(arg)
+	case TypeName:
+		value, err = deparseNode(arg, Context_None)
+	default:
+		value, err = deparseNode(arg, Context_AConst)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	result := fmt.Sprintf("%s = %s", name, *value)
+	return &result, nil
 }
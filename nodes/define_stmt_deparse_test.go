@@ -0,0 +1,133 @@
+/*
+ * Copyright (c) 2019 Ready Stock
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+package pg_query
+
+import (
+	"testing"
+)
+
+func Test_DefineStmt_Aggregate(t *testing.T) {
+	DoTest(t, DeparseTest{
+		Query:    `CREATE AGGREGATE newavg (int4) (sfunc = int4_accum, stype = _numeric, finalfunc = numeric_avg, initcond1 = '{0,0,0}');`,
+		Expected: `CREATE AGGREGATE "newavg" (int4) (sfunc = int4_accum, stype = _numeric, finalfunc = numeric_avg, initcond1 = '{0,0,0}')`,
+	})
+}
+
+func Test_DefineStmt_AggregateStar(t *testing.T) {
+	DoTest(t, DeparseTest{
+		Query:    `CREATE AGGREGATE newcnt (*) (sfunc = int8inc, stype = int8, initcond = '0', parallel = safe);`,
+		Expected: `CREATE AGGREGATE "newcnt" (*) (sfunc = int8inc, stype = int8, initcond = '0', parallel = safe)`,
+	})
+}
+
+func Test_DefineStmt_AggregateOrderedSet(t *testing.T) {
+	DoTest(t, DeparseTest{
+		Query:    `CREATE AGGREGATE my_percentile (float8 ORDER BY anyelement) (stype = internal, sfunc = ordered_set_transition, finalfunc = percentile_disc_final, finalfunc_extra);`,
+		Expected: `CREATE AGGREGATE "my_percentile" (float8 ORDER BY anyelement) (stype = internal, sfunc = ordered_set_transition, finalfunc = percentile_disc_final, finalfunc_extra)`,
+	})
+}
+
+func Test_DefineStmt_AggregateHypothetical(t *testing.T) {
+	DoTest(t, DeparseTest{
+		Query:    `CREATE AGGREGATE test_rank (VARIADIC text ORDER BY VARIADIC text) (sfunc = ordered_set_transition_multi, stype = internal, finalfunc = rank_final, finalfunc_extra, hypothetical);`,
+		Expected: `CREATE AGGREGATE "test_rank" (VARIADIC text ORDER BY VARIADIC text) (sfunc = ordered_set_transition_multi, stype = internal, finalfunc = rank_final, finalfunc_extra, hypothetical)`,
+	})
+}
+
+func Test_DefineStmt_AggregateOldStyle(t *testing.T) {
+	DoTest(t, DeparseTest{
+		Query:    `CREATE AGGREGATE oldcnt (sfunc = int8inc, basetype = 'ANY', stype = int8, initcond = '0');`,
+		Expected: `CREATE AGGREGATE "oldcnt" (sfunc = int8inc, basetype = 'ANY', stype = int8, initcond = '0')`,
+	})
+}
+
+func Test_DefineStmt_Operator(t *testing.T) {
+	DoTest(t, DeparseTest{
+		Query:    `CREATE OPERATOR ## (leftarg = path, rightarg = path, procedure = path_inter, commutator = ##);`,
+		Expected: `CREATE OPERATOR ## (leftarg = path, rightarg = path, procedure = path_inter, commutator = ##)`,
+	})
+}
+
+func Test_DefineStmt_OperatorPrefix(t *testing.T) {
+	DoTest(t, DeparseTest{
+		Query:    `CREATE OPERATOR alter1.@- (rightarg = int4, procedure = int4um, negator = OPERATOR(alter1.@-));`,
+		Expected: `CREATE OPERATOR alter1.@- (rightarg = int4, procedure = int4um, negator = OPERATOR(alter1.@-))`,
+	})
+}
+
+func Test_DefineStmt_Type(t *testing.T) {
+	DoTest(t, DeparseTest{
+		Query:    `CREATE TYPE widget (internallength = 24, input = widget_in, output = widget_out, typmod_in = numerictypmodin, alignment = double);`,
+		Expected: `CREATE TYPE "widget" (internallength = 24, input = widget_in, output = widget_out, typmod_in = numerictypmodin, alignment = double)`,
+	})
+}
+
+func Test_DefineStmt_ShellType(t *testing.T) {
+	DoTest(t, DeparseTest{
+		Query:    `CREATE TYPE shell;`,
+		Expected: `CREATE TYPE "shell"`,
+	})
+}
+
+func Test_DefineStmt_Collation(t *testing.T) {
+	DoTest(t, DeparseTest{
+		Query:    `CREATE COLLATION mycoll FROM "C";`,
+		Expected: `CREATE COLLATION "mycoll" FROM "C"`,
+	})
+}
+
+func Test_DefineStmt_TextSearch(t *testing.T) {
+	DoTest(t, DeparseTest{
+		Query:    `CREATE TEXT SEARCH DICTIONARY ispell (template = ispell, dictfile = ispell_sample, afffile = ispell_sample);`,
+		Expected: `CREATE TEXT SEARCH DICTIONARY "ispell" (template = ispell, dictfile = ispell_sample, afffile = ispell_sample)`,
+	})
+}
+
+func Test_CompositeTypeStmt(t *testing.T) {
+	DoTest(t, DeparseTest{
+		Query:    `CREATE TYPE complex AS (r float8, i float8);`,
+		Expected: `CREATE TYPE "complex" AS (r float8, i float8)`,
+	})
+}
+
+func Test_CreateEnumStmt(t *testing.T) {
+	DoTest(t, DeparseTest{
+		Query:    `CREATE TYPE rainbow AS ENUM ('red', 'orange', 'yellow');`,
+		Expected: `CREATE TYPE "rainbow" AS ENUM ('red', 'orange', 'yellow')`,
+	})
+}
+
+func Test_AlterEnumStmt_AddValue(t *testing.T) {
+	DoTest(t, DeparseTest{
+		Query:    `ALTER TYPE planets ADD VALUE IF NOT EXISTS 'pluto' AFTER 'neptune';`,
+		Expected: `ALTER TYPE "planets" ADD VALUE IF NOT EXISTS 'pluto' AFTER 'neptune'`,
+	})
+}
+
+func Test_AlterEnumStmt_RenameValue(t *testing.T) {
+	DoTest(t, DeparseTest{
+		Query:    `ALTER TYPE rainbow RENAME VALUE 'red' TO 'crimson';`,
+		Expected: `ALTER TYPE "rainbow" RENAME VALUE 'red' TO 'crimson'`,
+	})
+}
+
+func Test_CreateRangeStmt(t *testing.T) {
+	DoTest(t, DeparseTest{
+		Query:    `CREATE TYPE float8range AS RANGE (subtype = float8, subtype_diff = float8mi);`,
+		Expected: `CREATE TYPE "float8range" AS RANGE (subtype = float8, subtype_diff = float8mi)`,
+	})
+}
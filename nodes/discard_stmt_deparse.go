@@ -2,6 +2,26 @@
 
 package pg_query
 
+import (
+	"fmt"
+
+	"github.com/juju/errors"
+)
+
+var (
+	discardModes = map[DiscardMode]string{
+		DISCARD_ALL:       "ALL",
+		DISCARD_PLANS:     "PLANS",
+		DISCARD_SEQUENCES: "SEQUENCES",
+		DISCARD_TEMP:      "TEMP",
+	}
+)
+
 func (node DiscardStmt) Deparse(ctx Context) (*string, error) {
-	panic("Not Implemented")
+	if mode, ok := discardModes[node.Target]; !ok {
+		return nil, errors.Errorf("cannot deparse discard target (%d)", node.Target)
+	} else {
+		result := fmt.Sprintf("DISCARD %s", mode)
+		return &result, nil
+	}
 }
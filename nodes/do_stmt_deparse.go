@@ -3,10 +3,30 @@
 package pg_query
 
 import (
-	"fmt"
+	"github.com/juju/errors"
+	"strings"
 )
 
 func (node DoStmt) Deparse(ctx Context) (*string, error) {
-	result := fmt.Sprintf("DO $$%s$$", node.Args.Items[0].(DefElem).Arg.(String).Str)
+	out := []string{"DO"}
+	for _, item := range node.Args.Items {
+		arg, ok := item.(DefElem)
+		if !ok || arg.Defname == nil {
+			return nil, errors.Errorf("invalid do statement argument: %T", item)
+		}
+		value, err := functionOptionString(arg)
+		if err != nil {
+			return nil, err
+		}
+		switch *arg.Defname {
+		case "language":
+			out = append(out, "LANGUAGE", quoteIdentifier(value))
+		case "as":
+			out = append(out, dollarQuote(value))
+		default:
+			return nil, errors.Errorf("cannot deparse do statement argument: %s", *arg.Defname)
+		}
+	}
+	result := strings.Join(out, " ")
 	return &result, nil
 }
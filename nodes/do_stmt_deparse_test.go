@@ -24,3 +24,10 @@ BEGIN
 END$$`,
 	})
 }
+
+func Test_DoStmt_Language(t *testing.T) {
+	DoTest(t, DeparseTest{
+		Query:    `DO LANGUAGE plpgsql $fn$BEGIN RAISE NOTICE '$$'; END$fn$;`,
+		Expected: `DO LANGUAGE plpgsql $body$BEGIN RAISE NOTICE '$$'; END$body$`,
+	})
+}
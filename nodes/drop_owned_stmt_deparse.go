@@ -2,6 +2,23 @@
 
 package pg_query
 
+import (
+	"strings"
+)
+
 func (node DropOwnedStmt) Deparse(ctx Context) (*string, error) {
-	panic("Not Implemented")
+	out := []string{"DROP OWNED BY"}
+
+	if roles, err := node.Roles.DeparseList(Context_None); err != nil {
+		return nil, err
+	} else {
+		out = append(out, strings.Join(roles, ", "))
+	}
+
+	if node.Behavior == DROP_CASCADE {
+		out = append(out, "CASCADE")
+	}
+
+	result := strings.Join(out, " ")
+	return &result, nil
 }
@@ -2,6 +2,22 @@
 
 package pg_query
 
+import (
+	"strings"
+)
+
 func (node DropRoleStmt) Deparse(ctx Context) (*string, error) {
-	panic("Not Implemented")
+	out := []string{"DROP ROLE"}
+	if node.MissingOk {
+		out = append(out, "IF EXISTS")
+	}
+
+	if roles, err := node.Roles.DeparseList(Context_None); err != nil {
+		return nil, err
+	} else {
+		out = append(out, strings.Join(roles, ", "))
+	}
+
+	result := strings.Join(out, " ")
+	return &result, nil
 }
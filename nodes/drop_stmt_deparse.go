@@ -5,6 +5,8 @@ package pg_query
 import (
     "fmt"
     "strings"
+
+    "github.com/juju/errors"
 )
 
 var (
@@ -24,7 +26,7 @@ func (node DropStmt) Deparse(ctx Context) (*string, error) {
     out := []string{"DROP", ""}
 
     if removeType, ok := dropStmtRemoveTypes[node.RemoveType]; !ok {
-        panic(fmt.Sprintf("cannot handle remove type [%s]", node.RemoveType.String()))
+        return nil, errors.Errorf("cannot deparse drop statement for remove type [%s]", node.RemoveType.String())
     } else {
         out[1] = removeType
     }
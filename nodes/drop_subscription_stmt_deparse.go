@@ -2,6 +2,27 @@
 
 package pg_query
 
+import (
+	"strings"
+
+	"github.com/juju/errors"
+)
+
 func (node DropSubscriptionStmt) Deparse(ctx Context) (*string, error) {
-	panic("Not Implemented")
+	out := []string{"DROP SUBSCRIPTION"}
+	if node.MissingOk {
+		out = append(out, "IF EXISTS")
+	}
+
+	if node.Subname == nil {
+		return nil, errors.New("subscription must have a name")
+	}
+	out = append(out, quoteIdentifier(*node.Subname))
+
+	if node.Behavior == DROP_CASCADE {
+		out = append(out, "CASCADE")
+	}
+
+	result := strings.Join(out, " ")
+	return &result, nil
 }
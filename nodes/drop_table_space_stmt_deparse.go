@@ -2,6 +2,23 @@
 
 package pg_query
 
+import (
+	"strings"
+
+	"github.com/juju/errors"
+)
+
 func (node DropTableSpaceStmt) Deparse(ctx Context) (*string, error) {
-	panic("Not Implemented")
+	out := []string{"DROP TABLESPACE"}
+	if node.MissingOk {
+		out = append(out, "IF EXISTS")
+	}
+
+	if node.Tablespacename == nil {
+		return nil, errors.New("tablespace name cannot be null in drop tablespace")
+	}
+	out = append(out, quoteIdentifier(*node.Tablespacename))
+
+	result := strings.Join(out, " ")
+	return &result, nil
 }
@@ -2,6 +2,30 @@
 
 package pg_query
 
+import (
+	"strings"
+
+	"github.com/juju/errors"
+)
+
 func (node DropUserMappingStmt) Deparse(ctx Context) (*string, error) {
-	panic("Not Implemented")
+	if node.User == nil || node.Servername == nil {
+		return nil, errors.New("user and server cannot be null in drop user mapping")
+	}
+
+	out := []string{"DROP USER MAPPING"}
+	if node.MissingOk {
+		out = append(out, "IF EXISTS")
+	}
+
+	if str, err := deparseNode(*node.User, Context_None); err != nil {
+		return nil, err
+	} else {
+		out = append(out, "FOR", *str)
+	}
+
+	out = append(out, "SERVER", quoteIdentifier(*node.Servername))
+
+	result := strings.Join(out, " ")
+	return &result, nil
 }
@@ -4,14 +4,20 @@ package pg_query
 
 import (
 	"strings"
+
+	"github.com/juju/errors"
 )
 
 func (node DropdbStmt) Deparse(ctx Context) (*string, error) {
+	if node.Dbname == nil {
+		return nil, errors.New("database name cannot be null in drop database")
+	}
+
 	out := []string{"DROP DATABASE"}
 	if node.MissingOk {
 		out = append(out, "IF EXISTS")
 	}
-	out = append(out, *node.Dbname)
+	out = append(out, quoteIdentifier(*node.Dbname))
 	result := strings.Join(out, " ")
 	return &result, nil
 }
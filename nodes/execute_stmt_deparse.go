@@ -2,6 +2,28 @@
 
 package pg_query
 
+import (
+	"fmt"
+	"strings"
+
+	"github.com/juju/errors"
+)
+
 func (node ExecuteStmt) Deparse(ctx Context) (*string, error) {
-	panic("Not Implemented")
+	if node.Name == nil {
+		return nil, errors.New("prepared statement must have a name")
+	}
+
+	out := []string{"EXECUTE", quoteIdentifier(*node.Name)}
+
+	if node.Params.Items != nil && len(node.Params.Items) > 0 {
+		if params, err := node.Params.DeparseList(Context_None); err != nil {
+			return nil, err
+		} else {
+			out = append(out, fmt.Sprintf("(%s)", strings.Join(params, ", ")))
+		}
+	}
+
+	result := strings.Join(out, " ")
+	return &result, nil
 }
@@ -2,6 +2,30 @@
 
 package pg_query
 
+import (
+	"fmt"
+	"strings"
+)
+
 func (node ExplainStmt) Deparse(ctx Context) (*string, error) {
-	panic("Not Implemented")
+	out := []string{"EXPLAIN"}
+
+	// The parenthesized form is used for all options since it is the only
+	// form that supports every one of them.
+	if node.Options.Items != nil && len(node.Options.Items) > 0 {
+		if options, err := node.Options.DeparseList(Context_None); err != nil {
+			return nil, err
+		} else {
+			out = append(out, fmt.Sprintf("(%s)", strings.Join(options, ", ")))
+		}
+	}
+
+	if str, err := deparseNode(node.Query, Context_None); err != nil {
+		return nil, err
+	} else {
+		out = append(out, *str)
+	}
+
+	result := strings.Join(out, " ")
+	return &result, nil
 }
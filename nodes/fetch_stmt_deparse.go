@@ -2,6 +2,59 @@
 
 package pg_query
 
+import (
+	"fmt"
+	"strings"
+
+	"github.com/juju/errors"
+)
+
 func (node FetchStmt) Deparse(ctx Context) (*string, error) {
-	panic("Not Implemented")
+	out := make([]string, 0)
+	if node.Ismove {
+		out = append(out, "MOVE")
+	} else {
+		out = append(out, "FETCH")
+	}
+
+	// Directions like NEXT and LAST are stored as a direction with a count, so
+	// the shortest form that results in the same direction is used.
+	switch node.Direction {
+	case FETCH_FORWARD:
+		if node.HowMany == 1 {
+			out = append(out, "NEXT")
+		} else if node.HowMany == FETCH_ALL {
+			out = append(out, "FORWARD ALL")
+		} else {
+			out = append(out, fmt.Sprintf("FORWARD %d", node.HowMany))
+		}
+	case FETCH_BACKWARD:
+		if node.HowMany == 1 {
+			out = append(out, "PRIOR")
+		} else if node.HowMany == FETCH_ALL {
+			out = append(out, "BACKWARD ALL")
+		} else {
+			out = append(out, fmt.Sprintf("BACKWARD %d", node.HowMany))
+		}
+	case FETCH_ABSOLUTE:
+		if node.HowMany == 1 {
+			out = append(out, "FIRST")
+		} else if node.HowMany == -1 {
+			out = append(out, "LAST")
+		} else {
+			out = append(out, fmt.Sprintf("ABSOLUTE %d", node.HowMany))
+		}
+	case FETCH_RELATIVE:
+		out = append(out, fmt.Sprintf("RELATIVE %d", node.HowMany))
+	default:
+		return nil, errors.Errorf("cannot deparse fetch direction (%d)", node.Direction)
+	}
+
+	if node.Portalname == nil {
+		return nil, errors.New("cursor must have a name")
+	}
+	out = append(out, "FROM", quoteIdentifier(*node.Portalname))
+
+	result := strings.Join(out, " ")
+	return &result, nil
 }
@@ -0,0 +1,47 @@
+/*
+ * Copyright (c) 2019 Ready Stock
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+package pg_query
+
+/*
+ * frameOptions is an OR of these bits.  The NONDEFAULT and BETWEEN bits are
+ * used so that ruleutils.c can tell which properties were specified and
+ * which were defaulted; the correct behavioral bits must be set either way.
+ * The START_foo and END_foo options must come in pairs of adjacent bits for
+ * the convenience of gram.y, even though some of them are useless/invalid.
+ * We will need more bits (and fields) to cover the full SQL:2008 option set.
+ */
+const (
+	FRAMEOPTION_NONDEFAULT                = 0x00001 /* any specified? */
+	FRAMEOPTION_RANGE                     = 0x00002 /* RANGE behavior */
+	FRAMEOPTION_ROWS                      = 0x00004 /* ROWS behavior */
+	FRAMEOPTION_BETWEEN                   = 0x00008 /* BETWEEN given? */
+	FRAMEOPTION_START_UNBOUNDED_PRECEDING = 0x00010 /* start is U. P. */
+	FRAMEOPTION_END_UNBOUNDED_PRECEDING   = 0x00020 /* (disallowed) */
+	FRAMEOPTION_START_UNBOUNDED_FOLLOWING = 0x00040 /* (disallowed) */
+	FRAMEOPTION_END_UNBOUNDED_FOLLOWING   = 0x00080 /* end is U. F. */
+	FRAMEOPTION_START_CURRENT_ROW         = 0x00100 /* start is C. R. */
+	FRAMEOPTION_END_CURRENT_ROW           = 0x00200 /* end is C. R. */
+	FRAMEOPTION_START_VALUE_PRECEDING     = 0x00400 /* start is V. P. */
+	FRAMEOPTION_END_VALUE_PRECEDING       = 0x00800 /* end is V. P. */
+	FRAMEOPTION_START_VALUE_FOLLOWING     = 0x01000 /* start is V. F. */
+	FRAMEOPTION_END_VALUE_FOLLOWING       = 0x02000 /* end is V. F. */
+
+	FRAMEOPTION_START_VALUE = FRAMEOPTION_START_VALUE_PRECEDING | FRAMEOPTION_START_VALUE_FOLLOWING
+	FRAMEOPTION_END_VALUE   = FRAMEOPTION_END_VALUE_PRECEDING | FRAMEOPTION_END_VALUE_FOLLOWING
+
+	FRAMEOPTION_DEFAULTS = FRAMEOPTION_RANGE | FRAMEOPTION_START_UNBOUNDED_PRECEDING | FRAMEOPTION_END_CURRENT_ROW
+)
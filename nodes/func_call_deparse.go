@@ -10,12 +10,15 @@ import (
 func (node FuncCall) Deparse(ctx Context) (*string, error) {
 	out := make([]string, 0)
 
-	args := make([]string, len(node.Args.Items))
 	args, err := deparseNodeList(node.Args.Items, Context_None)
 	if err != nil {
 		return nil, err
 	}
 
+	if node.FuncVariadic && len(args) > 0 {
+		args[len(args)-1] = fmt.Sprintf("VARIADIC %s", args[len(args)-1])
+	}
+
 	if node.AggStar {
 		args = append(args, "*")
 	}
@@ -25,15 +28,48 @@ func (node FuncCall) Deparse(ctx Context) (*string, error) {
 		return nil, err
 	}
 
+	if special, ok := node.deparseSpecialSyntax(args); ok {
+		return &special, nil
+	}
+
 	distinct := ""
 	if node.AggDistinct {
 		distinct = "DISTINCT "
 	}
 
-	out = append(out, fmt.Sprintf("%s(%s%s)", funcName, distinct, strings.Join(args, ", ")))
+	// The ordering of an ordered-set aggregate is written after the arguments,
+	// any other aggregate ordering goes inside of them.
+	order := ""
+	if node.AggOrder.Items != nil && len(node.AggOrder.Items) > 0 {
+		if items, err := node.AggOrder.DeparseList(Context_None); err != nil {
+			return nil, err
+		} else {
+			order = fmt.Sprintf("ORDER BY %s", strings.Join(items, ", "))
+		}
+	}
+
+	if order != "" && !node.AggWithinGroup {
+		out = append(out, fmt.Sprintf("%s(%s%s %s)", funcName, distinct, strings.Join(args, ", "), order))
+	} else {
+		out = append(out, fmt.Sprintf("%s(%s%s)", funcName, distinct, strings.Join(args, ", ")))
+	}
+
+	if order != "" && node.AggWithinGroup {
+		out = append(out, fmt.Sprintf("WITHIN GROUP (%s)", order))
+	}
+
+	if node.AggFilter != nil {
+		if filter, err := deparseNode(node.AggFilter, Context_None); err != nil {
+			return nil, err
+		} else {
+			out = append(out, fmt.Sprintf("FILTER (WHERE %s)", *filter))
+		}
+	}
 
 	if node.Over != nil {
-		if over, err := deparseNode(node.Over, Context_None); err != nil {
+		if node.Over.Name != nil {
+			out = append(out, fmt.Sprintf("OVER %s", quoteIdentifier(*node.Over.Name)))
+		} else if over, err := node.Over.Deparse(Context_None); err != nil {
 			return nil, err
 		} else {
 			out = append(out, fmt.Sprintf("OVER (%s)", *over))
@@ -43,3 +79,32 @@ func (node FuncCall) Deparse(ctx Context) (*string, error) {
 	result := strings.Join(out, " ")
 	return &result, nil
 }
+
+// deparseSpecialSyntax returns the SQL standard syntax of functions that the
+// parser turns into regular calls of functions in pg_catalog, the arguments of
+// these calls are not in the order that they are written in.
+func (node FuncCall) deparseSpecialSyntax(args []string) (string, bool) {
+	if len(node.Funcname.Items) != 2 {
+		return "", false
+	}
+
+	schema, ok := node.Funcname.Items[0].(String)
+	if !ok || schema.Str != "pg_catalog" {
+		return "", false
+	}
+
+	name, ok := node.Funcname.Items[1].(String)
+	if !ok {
+		return "", false
+	}
+
+	switch {
+	case name.Str == "position" && len(args) == 2:
+		return fmt.Sprintf("POSITION(%s IN %s)", args[1], args[0]), true
+	case name.Str == "overlay" && len(args) == 3:
+		return fmt.Sprintf("OVERLAY(%s PLACING %s FROM %s)", args[0], args[1], args[2]), true
+	case name.Str == "overlay" && len(args) == 4:
+		return fmt.Sprintf("OVERLAY(%s PLACING %s FROM %s FOR %s)", args[0], args[1], args[2], args[3]), true
+	}
+	return "", false
+}
@@ -23,6 +23,41 @@ import (
 func Test_FuncCall_Generic(t *testing.T) {
 	DoTest(t, DeparseTest{
 		Query:    `select current_database() as a, current_schemas(false) as b`,
-		Expected: `SELECT pg_catalog.current_database() AS a, pg_catalog.current_schemas(false) AS b`,
+		Expected: `SELECT current_database() AS a, current_schemas(false) AS b`,
+	})
+}
+
+func Test_FuncCall_QuotedName(t *testing.T) {
+	DoTest(t, DeparseTest{
+		Query:    `SELECT "Foo"(1), "select"(2), "left"(3);`,
+		Expected: `SELECT "Foo"(1), "select"(2), "left"(3)`,
+	})
+}
+
+func Test_FuncCall_QualifiedName(t *testing.T) {
+	DoTest(t, DeparseTest{
+		Query:    `SELECT s.f(2), pg_catalog.lower('A'), "S"."F"(3);`,
+		Expected: `SELECT s.f(2), lower('A'), "S"."F"(3)`,
+	})
+}
+
+func Test_FuncCall_Position(t *testing.T) {
+	DoTest(t, DeparseTest{
+		Query:    `SELECT position('a' IN b) FROM t`,
+		Expected: `SELECT POSITION('a' IN "b") FROM "t"`,
+	})
+}
+
+func Test_FuncCall_Overlay(t *testing.T) {
+	DoTest(t, DeparseTest{
+		Query:    `SELECT overlay(a PLACING 'x' FROM 2), overlay(a PLACING 'x' FROM 2 FOR 1) FROM t`,
+		Expected: `SELECT OVERLAY("a" PLACING 'x' FROM 2), OVERLAY("a" PLACING 'x' FROM 2 FOR 1) FROM "t"`,
+	})
+}
+
+func Test_FuncCall_NamedArg(t *testing.T) {
+	DoTest(t, DeparseTest{
+		Query:    `SELECT f("Arg" => 1, "select" => 2)`,
+		Expected: `SELECT f("Arg" => 1, "select" => 2)`,
 	})
 }
@@ -20,36 +20,25 @@ import (
 	"strings"
 )
 
+// Name returns the possibly qualified name of the called function. The parser
+// qualifies the functions behind special syntax such as EXTRACT with
+// pg_catalog, which is implicitly part of every search path, so it is left out.
 func (node FuncCall) Name() (string, error) {
-	difference := func(slice1 []string, slice2 []string) []string {
-		var diff []string
-		// Loop two times, first to find slice1 strings not in slice2,
-		// second loop to find slice2 strings not in slice1
-		for i := 0; i < 2; i++ {
-			for _, s1 := range slice1 {
-				found := false
-				for _, s2 := range slice2 {
-					if s1 == s2 {
-						found = true
-						break
-					}
-				}
-				// String not found. We add it to return slice
-				if !found {
-					diff = append(diff, s1)
-				}
-			}
-			// Swap the slices, only if it was the first loop
-			if i == 0 {
-				slice1, slice2 = slice2, slice1
-			}
-		}
-		return diff
+	names, err := node.Funcname.DeparseList(Context_FuncCall)
+	if err != nil {
+		return "", err
 	}
 
-	if names, err := node.Funcname.DeparseList(Context_FuncCall); err != nil {
-		return "", err
-	} else {
-		return strings.Join(difference([]string{"pg_catalog"}, names), "."), nil
+	if len(names) > 1 && names[0] == "pg_catalog" {
+		names = names[1:]
 	}
+
+	return strings.Join(names, "."), nil
+}
+
+// isWindowless reports whether the call can be written where the grammar
+// only accepts function calls without a FILTER, OVER or WITHIN GROUP clause,
+// such as index and partition key elements that are not parenthesized.
+func (node FuncCall) isWindowless() bool {
+	return node.Over == nil && node.AggFilter == nil && !node.AggWithinGroup
 }
@@ -2,6 +2,48 @@
 
 package pg_query
 
+import (
+	"github.com/juju/errors"
+	"strings"
+)
+
+var (
+	functionParameterModes = map[FunctionParameterMode]string{
+		FUNC_PARAM_OUT:      "OUT",
+		FUNC_PARAM_INOUT:    "INOUT",
+		FUNC_PARAM_VARIADIC: "VARIADIC",
+	}
+)
+
 func (node FunctionParameter) Deparse(ctx Context) (*string, error) {
-	panic("Not Implemented")
+	out := make([]string, 0)
+	if mode, ok := functionParameterModes[node.Mode]; ok {
+		out = append(out, mode)
+	}
+
+	if node.Name != nil {
+		out = append(out, quoteIdentifier(*node.Name))
+	}
+
+	if node.ArgType == nil {
+		return nil, errors.New("function parameter must have a type")
+	}
+
+	if str, err := deparseNode(*node.ArgType, Context_None); err != nil {
+		return nil, err
+	} else {
+		out = append(out, *str)
+	}
+
+	if node.Defexpr != nil {
+		out = append(out, "DEFAULT")
+		if str, err := deparseNode(node.Defexpr, Context_None); err != nil {
+			return nil, err
+		} else {
+			out = append(out, *str)
+		}
+	}
+
+	result := strings.Join(out, " ")
+	return &result, nil
 }
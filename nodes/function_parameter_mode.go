@@ -6,9 +6,9 @@ type FunctionParameterMode uint
 
 const (
 	/* the assigned enum values appear in pg_proc, don't change 'em! */
-	FUNC_PARAM_IN FunctionParameterMode = iota
-	FUNC_PARAM_OUT
-	FUNC_PARAM_INOUT
-	FUNC_PARAM_VARIADIC
-	FUNC_PARAM_TABLE
+	FUNC_PARAM_IN       FunctionParameterMode = 'i' /* input only */
+	FUNC_PARAM_OUT      FunctionParameterMode = 'o' /* output only */
+	FUNC_PARAM_INOUT    FunctionParameterMode = 'b' /* both */
+	FUNC_PARAM_VARIADIC FunctionParameterMode = 'v' /* variadic (always input) */
+	FUNC_PARAM_TABLE    FunctionParameterMode = 't' /* table function output column */
 )
@@ -2,6 +2,63 @@
 
 package pg_query
 
+import (
+	"strings"
+
+	"github.com/juju/errors"
+)
+
 func (node GrantRoleStmt) Deparse(ctx Context) (*string, error) {
-	panic("Not Implemented")
+	out := make([]string, 0)
+	if node.IsGrant {
+		out = append(out, "GRANT")
+	} else {
+		out = append(out, "REVOKE")
+		if node.AdminOpt {
+			out = append(out, "ADMIN OPTION FOR")
+		}
+	}
+
+	// The granted roles are parsed as privileges, but their names need to be
+	// kept as they are rather than treated like a keyword.
+	roles := make([]string, len(node.GrantedRoles.Items))
+	for i, item := range node.GrantedRoles.Items {
+		role, ok := item.(AccessPriv)
+		if !ok || role.PrivName == nil {
+			return nil, errors.Errorf("cannot deparse granted role of type %T", item)
+		}
+		roles[i] = quoteIdentifier(*role.PrivName)
+	}
+	out = append(out, strings.Join(roles, ", "))
+
+	if node.IsGrant {
+		out = append(out, "TO")
+	} else {
+		out = append(out, "FROM")
+	}
+
+	if grantees, err := node.GranteeRoles.DeparseList(Context_None); err != nil {
+		return nil, err
+	} else {
+		out = append(out, strings.Join(grantees, ", "))
+	}
+
+	if node.IsGrant && node.AdminOpt {
+		out = append(out, "WITH ADMIN OPTION")
+	}
+
+	if node.Grantor != nil {
+		if str, err := deparseNode(*node.Grantor, Context_None); err != nil {
+			return nil, err
+		} else {
+			out = append(out, "GRANTED BY", *str)
+		}
+	}
+
+	if node.Behavior == DROP_CASCADE {
+		out = append(out, "CASCADE")
+	}
+
+	result := strings.Join(out, " ")
+	return &result, nil
 }
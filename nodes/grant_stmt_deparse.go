@@ -2,6 +2,130 @@
 
 package pg_query
 
+import (
+	"github.com/juju/errors"
+	"strings"
+)
+
+var (
+	grantObjectTypes = map[GrantObjectType]string{
+		ACL_OBJECT_RELATION:       "TABLE",
+		ACL_OBJECT_SEQUENCE:       "SEQUENCE",
+		ACL_OBJECT_DATABASE:       "DATABASE",
+		ACL_OBJECT_DOMAIN:         "DOMAIN",
+		ACL_OBJECT_FDW:            "FOREIGN DATA WRAPPER",
+		ACL_OBJECT_FOREIGN_SERVER: "FOREIGN SERVER",
+		ACL_OBJECT_FUNCTION:       "FUNCTION",
+		ACL_OBJECT_LANGUAGE:       "LANGUAGE",
+		ACL_OBJECT_LARGEOBJECT:    "LARGE OBJECT",
+		ACL_OBJECT_NAMESPACE:      "SCHEMA",
+		ACL_OBJECT_TABLESPACE:     "TABLESPACE",
+		ACL_OBJECT_TYPE:           "TYPE",
+	}
+
+	// grantObjectTypesPlural are used when granting on all objects in a schema or
+	// when changing default privileges.
+	grantObjectTypesPlural = map[GrantObjectType]string{
+		ACL_OBJECT_RELATION:  "TABLES",
+		ACL_OBJECT_SEQUENCE:  "SEQUENCES",
+		ACL_OBJECT_FUNCTION:  "FUNCTIONS",
+		ACL_OBJECT_TYPE:      "TYPES",
+		ACL_OBJECT_NAMESPACE: "SCHEMAS",
+	}
+)
+
 func (node GrantStmt) Deparse(ctx Context) (*string, error) {
-	panic("Not Implemented")
+	out := make([]string, 0)
+	if node.IsGrant {
+		out = append(out, "GRANT")
+	} else {
+		out = append(out, "REVOKE")
+		if node.GrantOption {
+			out = append(out, "GRANT OPTION FOR")
+		}
+	}
+
+	if node.Privileges.Items == nil || len(node.Privileges.Items) == 0 {
+		out = append(out, "ALL")
+	} else {
+		if privileges, err := node.Privileges.DeparseList(Context_None); err != nil {
+			return nil, err
+		} else {
+			out = append(out, strings.Join(privileges, ", "))
+		}
+	}
+
+	out = append(out, "ON")
+
+	switch node.Targtype {
+	case ACL_TARGET_OBJECT:
+		if objtype, ok := grantObjectTypes[node.Objtype]; !ok {
+			return nil, errors.Errorf("cannot deparse grant object type (%d)", node.Objtype)
+		} else {
+			out = append(out, objtype)
+		}
+
+		objects := make([]string, len(node.Objects.Items))
+		for i, object := range node.Objects.Items {
+			switch obj := object.(type) {
+			case List:
+				// Domains and types are referenced by possibly qualified names.
+				if names, err := obj.DeparseList(Context_None); err != nil {
+					return nil, err
+				} else {
+					objects[i] = strings.Join(names, ".")
+				}
+			default:
+				if str, err := obj.Deparse(Context_None); err != nil {
+					return nil, err
+				} else {
+					objects[i] = *str
+				}
+			}
+		}
+		out = append(out, strings.Join(objects, ", "))
+	case ACL_TARGET_ALL_IN_SCHEMA:
+		if objtype, ok := grantObjectTypesPlural[node.Objtype]; !ok {
+			return nil, errors.Errorf("cannot deparse grant object type (%d) in schema", node.Objtype)
+		} else {
+			out = append(out, "ALL", objtype, "IN SCHEMA")
+		}
+
+		if schemas, err := node.Objects.DeparseList(Context_None); err != nil {
+			return nil, err
+		} else {
+			out = append(out, strings.Join(schemas, ", "))
+		}
+	case ACL_TARGET_DEFAULTS:
+		if objtype, ok := grantObjectTypesPlural[node.Objtype]; !ok {
+			return nil, errors.Errorf("cannot deparse default privileges for object type (%d)", node.Objtype)
+		} else {
+			out = append(out, objtype)
+		}
+	default:
+		return nil, errors.Errorf("cannot deparse grant target type (%d)", node.Targtype)
+	}
+
+	if node.IsGrant {
+		out = append(out, "TO")
+	} else {
+		out = append(out, "FROM")
+	}
+
+	if grantees, err := node.Grantees.DeparseList(Context_None); err != nil {
+		return nil, err
+	} else {
+		out = append(out, strings.Join(grantees, ", "))
+	}
+
+	if node.IsGrant && node.GrantOption {
+		out = append(out, "WITH GRANT OPTION")
+	}
+
+	if node.Behavior == DROP_CASCADE {
+		out = append(out, "CASCADE")
+	}
+
+	result := strings.Join(out, " ")
+	return &result, nil
 }
@@ -0,0 +1,133 @@
+/*
+ * Copyright (c) 2019 Ready Stock
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+package pg_query
+
+import (
+	"testing"
+)
+
+func Test_GrantStmt_Table(t *testing.T) {
+	DoTest(t, DeparseTest{
+		Query:    `GRANT SELECT ON atest4 TO GROUP regress_group1 WITH GRANT OPTION;`,
+		Expected: `GRANT SELECT ON TABLE "atest4" TO regress_group1 WITH GRANT OPTION`,
+	})
+	DoTest(t, DeparseTest{
+		Query:    `GRANT SELECT (one, two), UPDATE (two) ON public.atest5 TO regress_user4, CURRENT_USER;`,
+		Expected: `GRANT SELECT ("one", "two"), UPDATE ("two") ON TABLE "public"."atest5" TO regress_user4, CURRENT_USER`,
+	})
+	DoTest(t, DeparseTest{
+		Query:    `GRANT ALL PRIVILEGES ON TABLE atest1, atest2 TO PUBLIC;`,
+		Expected: `GRANT ALL ON TABLE "atest1", "atest2" TO PUBLIC`,
+	})
+	DoTest(t, DeparseTest{
+		Query:    `GRANT USAGE on SEQUENCE x_seq to regress_user2;`,
+		Expected: `GRANT USAGE ON SEQUENCE "x_seq" TO regress_user2`,
+	})
+}
+
+func Test_GrantStmt_Revoke(t *testing.T) {
+	DoTest(t, DeparseTest{
+		Query:    `REVOKE ALL (one) ON atest5 FROM regress_user4;`,
+		Expected: `REVOKE ALL ("one") ON TABLE "atest5" FROM regress_user4`,
+	})
+	DoTest(t, DeparseTest{
+		Query:    `REVOKE GRANT OPTION FOR SELECT ON atest4 FROM regress_user2 CASCADE;`,
+		Expected: `REVOKE GRANT OPTION FOR SELECT ON TABLE "atest4" FROM regress_user2 CASCADE`,
+	})
+	DoTest(t, DeparseTest{
+		Query:    `REVOKE ALL ON FUNCTION int8(integer) FROM PUBLIC;`,
+		Expected: `REVOKE ALL ON FUNCTION int8(int) FROM PUBLIC`,
+	})
+}
+
+func Test_GrantStmt_OtherObjects(t *testing.T) {
+	DoTest(t, DeparseTest{
+		Query:    `GRANT CREATE, CONNECT ON DATABASE regression TO regress_user1;`,
+		Expected: `GRANT CREATE, CONNECT ON DATABASE "regression" TO regress_user1`,
+	})
+	DoTest(t, DeparseTest{
+		Query:    `REVOKE USAGE ON FOREIGN SERVER s8 FROM regress_test_role;`,
+		Expected: `REVOKE USAGE ON FOREIGN SERVER "s8" FROM regress_test_role`,
+	})
+	DoTest(t, DeparseTest{
+		Query:    `GRANT USAGE ON FOREIGN DATA WRAPPER foo TO regress_test_role WITH GRANT OPTION;`,
+		Expected: `GRANT USAGE ON FOREIGN DATA WRAPPER "foo" TO regress_test_role WITH GRANT OPTION`,
+	})
+	DoTest(t, DeparseTest{
+		Query:    `GRANT USAGE ON TYPE public.testtype1 TO regress_user2;`,
+		Expected: `GRANT USAGE ON TYPE "public"."testtype1" TO regress_user2`,
+	})
+	DoTest(t, DeparseTest{
+		Query:    `GRANT CREATE ON SCHEMA testns, other TO SESSION_USER;`,
+		Expected: `GRANT CREATE ON SCHEMA "testns", "other" TO SESSION_USER`,
+	})
+	DoTest(t, DeparseTest{
+		Query:    `GRANT SELECT ON LARGE OBJECT 1001 TO PUBLIC;`,
+		Expected: `GRANT SELECT ON LARGE OBJECT 1001 TO PUBLIC`,
+	})
+}
+
+func Test_GrantStmt_AllInSchema(t *testing.T) {
+	DoTest(t, DeparseTest{
+		Query:    `GRANT SELECT ON ALL TABLES IN SCHEMA testns TO regress_user1;`,
+		Expected: `GRANT SELECT ON ALL TABLES IN SCHEMA "testns" TO regress_user1`,
+	})
+	DoTest(t, DeparseTest{
+		Query:    `REVOKE EXECUTE ON ALL FUNCTIONS IN SCHEMA testns FROM PUBLIC;`,
+		Expected: `REVOKE EXECUTE ON ALL FUNCTIONS IN SCHEMA "testns" FROM PUBLIC`,
+	})
+}
+
+func Test_GrantStmt_DefaultPrivileges(t *testing.T) {
+	DoTest(t, DeparseTest{
+		Query:    `ALTER DEFAULT PRIVILEGES IN SCHEMA testns REVOKE INSERT ON TABLES FROM regress_user1;`,
+		Expected: `ALTER DEFAULT PRIVILEGES IN SCHEMA "testns" REVOKE INSERT ON TABLES FROM regress_user1`,
+	})
+	DoTest(t, DeparseTest{
+		Query:    `ALTER DEFAULT PRIVILEGES FOR ROLE regress_user1 IN SCHEMA testns GRANT EXECUTE ON FUNCTIONS to public;`,
+		Expected: `ALTER DEFAULT PRIVILEGES FOR ROLE regress_user1 IN SCHEMA "testns" GRANT EXECUTE ON FUNCTIONS TO PUBLIC`,
+	})
+	DoTest(t, DeparseTest{
+		Query:    `ALTER DEFAULT PRIVILEGES GRANT USAGE ON TYPES TO regress_user2 WITH GRANT OPTION;`,
+		Expected: `ALTER DEFAULT PRIVILEGES GRANT USAGE ON TYPES TO regress_user2 WITH GRANT OPTION`,
+	})
+}
+
+func Test_GrantRoleStmt(t *testing.T) {
+	DoTest(t, DeparseTest{
+		Query:    `GRANT regress_group1, regress_group2 TO regress_user4 WITH ADMIN OPTION;`,
+		Expected: `GRANT regress_group1, regress_group2 TO regress_user4 WITH ADMIN OPTION`,
+	})
+	DoTest(t, DeparseTest{
+		Query:    `REVOKE ADMIN OPTION FOR regress_group2 FROM regress_user5 CASCADE;`,
+		Expected: `REVOKE ADMIN OPTION FOR regress_group2 FROM regress_user5 CASCADE`,
+	})
+}
+
+func Test_GrantRoleStmt_QuotedNames(t *testing.T) {
+	DoTest(t, DeparseTest{
+		Query:    `GRANT "Admins" TO "current_user", "Public", PUBLIC;`,
+		Expected: `GRANT "Admins" TO "current_user", "Public", PUBLIC`,
+	})
+}
+
+func Test_GrantStmt_QuotedGrantee(t *testing.T) {
+	DoTest(t, DeparseTest{
+		Query:    `GRANT SELECT ON t TO "Bob", "public", SESSION_USER;`,
+		Expected: `GRANT SELECT ON TABLE "t" TO "Bob", PUBLIC, SESSION_USER`,
+	})
+}
@@ -2,6 +2,16 @@
 
 package pg_query
 
+import (
+	"fmt"
+	"strings"
+)
+
 func (node GroupingFunc) Deparse(ctx Context) (*string, error) {
-	panic("Not Implemented")
+	if args, err := node.Args.DeparseList(Context_None); err != nil {
+		return nil, err
+	} else {
+		result := fmt.Sprintf("GROUPING(%s)", strings.Join(args, ", "))
+		return &result, nil
+	}
 }
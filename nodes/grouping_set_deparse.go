@@ -2,6 +2,37 @@
 
 package pg_query
 
+import (
+	"fmt"
+	"strings"
+
+	"github.com/juju/errors"
+)
+
+var (
+	groupingSetKinds = map[GroupingSetKind]string{
+		GROUPING_SET_EMPTY:  "",
+		GROUPING_SET_SIMPLE: "",
+		GROUPING_SET_ROLLUP: "ROLLUP",
+		GROUPING_SET_CUBE:   "CUBE",
+		GROUPING_SET_SETS:   "GROUPING SETS",
+	}
+)
+
 func (node GroupingSet) Deparse(ctx Context) (*string, error) {
-	panic("Not Implemented")
+	kind, ok := groupingSetKinds[node.Kind]
+	if !ok {
+		return nil, errors.Errorf("cannot deparse grouping set kind (%d)", node.Kind)
+	}
+
+	content, err := node.Content.DeparseList(Context_None)
+	if err != nil {
+		return nil, err
+	}
+
+	result := fmt.Sprintf("(%s)", strings.Join(content, ", "))
+	if kind != "" {
+		result = fmt.Sprintf("%s %s", kind, result)
+	}
+	return &result, nil
 }
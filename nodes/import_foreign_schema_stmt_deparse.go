@@ -2,6 +2,53 @@
 
 package pg_query
 
+import (
+	"fmt"
+	"strings"
+
+	"github.com/juju/errors"
+)
+
+var (
+	importForeignSchemaTypes = map[ImportForeignSchemaType]string{
+		FDW_IMPORT_SCHEMA_ALL:      "",
+		FDW_IMPORT_SCHEMA_LIMIT_TO: "LIMIT TO",
+		FDW_IMPORT_SCHEMA_EXCEPT:   "EXCEPT",
+	}
+)
+
 func (node ImportForeignSchemaStmt) Deparse(ctx Context) (*string, error) {
-	panic("Not Implemented")
+	if node.RemoteSchema == nil || node.ServerName == nil || node.LocalSchema == nil {
+		return nil, errors.New("import foreign schema must have a remote schema, server and local schema")
+	}
+
+	out := []string{"IMPORT FOREIGN SCHEMA", quoteIdentifier(*node.RemoteSchema)}
+
+	if listType, ok := importForeignSchemaTypes[node.ListType]; !ok {
+		return nil, errors.Errorf("cannot deparse import foreign schema list type (%d)", node.ListType)
+	} else if listType != "" {
+		// The remote tables are named without a schema, which is given above.
+		tables := make([]string, len(node.TableList.Items))
+		for i, item := range node.TableList.Items {
+			table, ok := item.(RangeVar)
+			if !ok || table.Relname == nil {
+				return nil, errors.Errorf("cannot deparse remote table of type %T", item)
+			}
+			tables[i] = quoteIdentifier(*table.Relname)
+		}
+		out = append(out, fmt.Sprintf("%s (%s)", listType, strings.Join(tables, ", ")))
+	}
+
+	out = append(out, "FROM SERVER", quoteIdentifier(*node.ServerName), "INTO", quoteIdentifier(*node.LocalSchema))
+
+	if node.Options.Items != nil && len(node.Options.Items) > 0 {
+		if str, err := deparseGenericOptions(node.Options); err != nil {
+			return nil, err
+		} else {
+			out = append(out, *str)
+		}
+	}
+
+	result := strings.Join(out, " ")
+	return &result, nil
 }
@@ -2,6 +2,73 @@
 
 package pg_query
 
+import (
+	"fmt"
+	"strings"
+
+	"github.com/juju/errors"
+)
+
 func (node IndexStmt) Deparse(ctx Context) (*string, error) {
-	panic("Not Implemented")
+	out := []string{"CREATE"}
+	if node.Unique {
+		out = append(out, "UNIQUE")
+	}
+
+	out = append(out, "INDEX")
+
+	if node.Concurrent {
+		out = append(out, "CONCURRENTLY")
+	}
+
+	if node.IfNotExists {
+		out = append(out, "IF NOT EXISTS")
+	}
+
+	if node.Idxname != nil {
+		out = append(out, quoteIdentifier(*node.Idxname))
+	}
+
+	if node.Relation == nil {
+		return nil, errors.New("index statement must have a relation")
+	}
+
+	if str, err := node.Relation.Deparse(Context_None); err != nil {
+		return nil, err
+	} else {
+		out = append(out, "ON", *str)
+	}
+
+	if node.AccessMethod != nil {
+		out = append(out, fmt.Sprintf("USING %s", quoteIdentifier(*node.AccessMethod)))
+	}
+
+	if params, err := node.IndexParams.DeparseList(Context_None); err != nil {
+		return nil, err
+	} else {
+		out = append(out, fmt.Sprintf("(%s)", strings.Join(params, ", ")))
+	}
+
+	if node.Options.Items != nil && len(node.Options.Items) > 0 {
+		if str, err := deparseRelOptions(node.Options); err != nil {
+			return nil, err
+		} else {
+			out = append(out, fmt.Sprintf("WITH %s", *str))
+		}
+	}
+
+	if node.TableSpace != nil {
+		out = append(out, fmt.Sprintf("TABLESPACE %s", quoteIdentifier(*node.TableSpace)))
+	}
+
+	if node.WhereClause != nil {
+		if str, err := deparseNode(node.WhereClause, Context_None); err != nil {
+			return nil, err
+		} else {
+			out = append(out, fmt.Sprintf("WHERE %s", *str))
+		}
+	}
+
+	result := strings.Join(out, " ")
+	return &result, nil
 }
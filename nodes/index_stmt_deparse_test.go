@@ -0,0 +1,126 @@
+/*
+ * Copyright (c) 2019 Ready Stock
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+package pg_query
+
+import (
+	"testing"
+)
+
+func Test_IndexStmt_Simple(t *testing.T) {
+	DoTest(t, DeparseTest{
+		Query:    `CREATE INDEX tenk1_thous_tenthous ON tenk1 (thousand, tenthous);`,
+		Expected: `CREATE INDEX tenk1_thous_tenthous ON "tenk1" USING btree ("thousand", "tenthous")`,
+	})
+	DoTest(t, DeparseTest{
+		Query:    `CREATE INDEX ON table2(col2);`,
+		Expected: `CREATE INDEX ON "table2" USING btree ("col2")`,
+	})
+}
+
+func Test_IndexStmt_Flags(t *testing.T) {
+	DoTest(t, DeparseTest{
+		Query:    `CREATE UNIQUE INDEX CONCURRENTLY IF NOT EXISTS concur_index2 ON concur_heap(f1);`,
+		Expected: `CREATE UNIQUE INDEX CONCURRENTLY IF NOT EXISTS concur_index2 ON "concur_heap" USING btree ("f1")`,
+	})
+}
+
+func Test_IndexStmt_AccessMethodAndOpclass(t *testing.T) {
+	DoTest(t, DeparseTest{
+		Query:    `CREATE INDEX sp_kd_ind ON kd_point_tbl USING spgist (p kd_point_ops);`,
+		Expected: `CREATE INDEX sp_kd_ind ON "kd_point_tbl" USING spgist ("p" kd_point_ops)`,
+	})
+	DoTest(t, DeparseTest{
+		Query:    `CREATE INDEX hash_tuplesort_idx ON tenk1 USING hash (stringu1 name_ops) WITH (fillfactor = 10);`,
+		Expected: `CREATE INDEX hash_tuplesort_idx ON "tenk1" USING hash ("stringu1" name_ops) WITH (fillfactor=10)`,
+	})
+}
+
+func Test_IndexStmt_Expressions(t *testing.T) {
+	DoTest(t, DeparseTest{
+		Query:    `CREATE UNIQUE INDEX func_index_index on func_index_heap (textcat(f1,f2));`,
+		Expected: `CREATE UNIQUE INDEX func_index_index ON "func_index_heap" USING btree (textcat("f1", "f2"))`,
+	})
+	DoTest(t, DeparseTest{
+		Query:    `CREATE UNIQUE INDEX func_index_index on func_index_heap ((f1 || f2) text_ops);`,
+		Expected: `CREATE UNIQUE INDEX func_index_index ON "func_index_heap" USING btree (("f1" || "f2") text_ops)`,
+	})
+	DoTest(t, DeparseTest{
+		Query:    `CREATE INDEX ON users (lower(email) COLLATE "C" DESC NULLS LAST);`,
+		Expected: `CREATE INDEX ON "users" USING btree (lower("email") COLLATE "C" DESC NULLS LAST)`,
+	})
+	DoTest(t, DeparseTest{
+		Query:    `CREATE INDEX ON t ((count(*) FILTER (WHERE a > 1)), (rank() OVER (ORDER BY b)));`,
+		Expected: `CREATE INDEX ON "t" USING btree ((count(*) FILTER (WHERE "a" > 1)), (rank() OVER (ORDER BY "b")))`,
+	})
+}
+
+func Test_IndexStmt_Ordering(t *testing.T) {
+	DoTest(t, DeparseTest{
+		Query:    `CREATE UNIQUE INDEX onek_nulltest ON onek_with_null (unique2 desc nulls last,unique1);`,
+		Expected: `CREATE UNIQUE INDEX onek_nulltest ON "onek_with_null" USING btree ("unique2" DESC NULLS LAST, "unique1")`,
+	})
+	DoTest(t, DeparseTest{
+		Query:    `CREATE UNIQUE INDEX onek_nulltest ON onek_with_null (unique2 nulls first,unique1 asc);`,
+		Expected: `CREATE UNIQUE INDEX onek_nulltest ON "onek_with_null" USING btree ("unique2" NULLS FIRST, "unique1" ASC)`,
+	})
+}
+
+func Test_IndexStmt_Partial(t *testing.T) {
+	DoTest(t, DeparseTest{
+		Query:    `create unique index hash_f8_index_3 on hash_f8_heap(random) where seqno > 1000;`,
+		Expected: `CREATE UNIQUE INDEX hash_f8_index_3 ON "hash_f8_heap" USING btree ("random") WHERE "seqno" > 1000`,
+	})
+	DoTest(t, DeparseTest{
+		Query:    `CREATE INDEX i ON t (a) TABLESPACE fast WHERE b IS NULL;`,
+		Expected: `CREATE INDEX i ON "t" USING btree ("a") TABLESPACE fast WHERE "b" IS NULL`,
+	})
+}
+
+func Test_IndexStmt_ExclusionConstraint(t *testing.T) {
+	DoTest(t, DeparseTest{
+		Query:    `ALTER TABLE circles ADD CONSTRAINT no_overlap EXCLUDE USING gist (c WITH &&) WHERE (active);`,
+		Expected: `ALTER TABLE "circles" ADD CONSTRAINT no_overlap EXCLUDE USING gist ("c" WITH &&) WHERE ("active")`,
+	})
+}
+
+func Test_IndexStmt_QuotedNames(t *testing.T) {
+	DoTest(t, DeparseTest{
+		Query:    `CREATE INDEX "Idx" ON t USING "Gist" (a) TABLESPACE "Ts"`,
+		Expected: `CREATE INDEX "Idx" ON "t" USING "Gist" ("a") TABLESPACE "Ts"`,
+	})
+}
+
+func Test_IndexStmt_KeywordName(t *testing.T) {
+	DoTest(t, DeparseTest{
+		Query:    `CREATE UNIQUE INDEX "select" ON t (a)`,
+		Expected: `CREATE UNIQUE INDEX "select" ON "t" USING btree ("a")`,
+	})
+}
+
+func Test_Constraint_QuotedNames(t *testing.T) {
+	DoTest(t, DeparseTest{
+		Query:    `CREATE TABLE t (a int, CONSTRAINT "Excl" EXCLUDE USING "Gist" (a WITH =), CONSTRAINT "Pk" PRIMARY KEY (a) USING INDEX TABLESPACE "Ts")`,
+		Expected: `CREATE TABLE "t" (a int, CONSTRAINT "Excl" EXCLUDE USING "Gist" ("a" WITH =), CONSTRAINT "Pk" PRIMARY KEY ("a") USING INDEX TABLESPACE "Ts")`,
+	})
+}
+
+func Test_Constraint_UsingIndexQuoted(t *testing.T) {
+	DoTest(t, DeparseTest{
+		Query:    `ALTER TABLE t ADD CONSTRAINT "Uq" UNIQUE USING INDEX "Idx"`,
+		Expected: `ALTER TABLE "t" ADD CONSTRAINT "Uq" UNIQUE USING INDEX "Idx"`,
+	})
+}
@@ -2,6 +2,35 @@
 
 package pg_query
 
+import (
+	"fmt"
+	"strings"
+
+	"github.com/juju/errors"
+)
+
 func (node InferClause) Deparse(ctx Context) (*string, error) {
-	panic("Not Implemented")
+	out := make([]string, 0)
+	if node.Conname != nil {
+		out = append(out, fmt.Sprintf("ON CONSTRAINT %s", quoteIdentifier(*node.Conname)))
+	} else if node.IndexElems.Items != nil && len(node.IndexElems.Items) > 0 {
+		if elems, err := node.IndexElems.DeparseList(Context_None); err != nil {
+			return nil, err
+		} else {
+			out = append(out, fmt.Sprintf("(%s)", strings.Join(elems, ", ")))
+		}
+	} else {
+		return nil, errors.New("infer clause must have either a constraint name or index elements")
+	}
+
+	if node.WhereClause != nil {
+		if str, err := deparseNode(node.WhereClause, Context_None); err != nil {
+			return nil, err
+		} else {
+			out = append(out, fmt.Sprintf("WHERE %s", *str))
+		}
+	}
+
+	result := strings.Join(out, " ")
+	return &result, nil
 }
@@ -2,6 +2,20 @@
 
 package pg_query
 
+import (
+	"github.com/juju/errors"
+)
+
+// InferenceElem nodes are produced by parse analysis from IndexElems, the
+// collation and operator class are only known by their OIDs at that point.
 func (node InferenceElem) Deparse(ctx Context) (*string, error) {
-	panic("Not Implemented")
+	if node.Infercollid != 0 || node.Inferopclass != 0 {
+		return nil, errors.New("cannot deparse inference element with a collation or operator class oid")
+	}
+
+	if node.Expr == nil {
+		return nil, errors.New("inference element must have an expression")
+	}
+
+	return deparseNode(node.Expr, Context_None)
 }
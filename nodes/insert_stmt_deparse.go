@@ -22,12 +22,22 @@ func (node InsertStmt) Deparse(ctx Context) (*string, error) {
 		return nil, errors.New("relation in insert cannot be null!")
 	}
 	out = append(out, "INSERT INTO")
-	if str, err := deparseNode(*node.Relation, Context_None); err != nil {
+	// The alias of the target relation requires the AS keyword.
+	relation := *node.Relation
+	relation.Alias = nil
+	if str, err := deparseNode(relation, Context_None); err != nil {
 		return nil, err
 	} else {
 		out = append(out, *str)
 	}
 
+	if node.Relation.Alias != nil {
+		if node.Relation.Alias.Aliasname == nil {
+			return nil, errors.New("alias of insert relation must have a name")
+		}
+		out = append(out, "AS", *node.Relation.Alias.Aliasname)
+	}
+
 	if node.Cols.Items != nil {
 		cols := make([]string, len(node.Cols.Items))
 		for i, col := range node.Cols.Items {
@@ -40,12 +50,22 @@ func (node InsertStmt) Deparse(ctx Context) (*string, error) {
 		out = append(out, fmt.Sprintf("(%s)", strings.Join(cols, ", ")))
 	}
 
-	if str, err := node.SelectStmt.Deparse(Context_None); err != nil {
+	if node.SelectStmt == nil {
+		out = append(out, "DEFAULT VALUES")
+	} else if str, err := node.SelectStmt.Deparse(Context_None); err != nil {
 		return nil, err
 	} else {
 		out = append(out, *str)
 	}
 
+	if node.OnConflictClause != nil {
+		if str, err := node.OnConflictClause.Deparse(Context_None); err != nil {
+			return nil, err
+		} else {
+			out = append(out, *str)
+		}
+	}
+
 	if node.ReturningList.Items != nil && len(node.ReturningList.Items) > 0 {
 		out = append(out, "RETURNING")
 		fields := make([]string, len(node.ReturningList.Items))
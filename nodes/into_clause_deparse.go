@@ -2,6 +2,60 @@
 
 package pg_query
 
+import (
+	"fmt"
+	"strings"
+
+	"github.com/juju/errors"
+)
+
+var (
+	onCommitActions = map[OnCommitAction]string{
+		ONCOMMIT_NOOP:          "",
+		ONCOMMIT_PRESERVE_ROWS: "ON COMMIT PRESERVE ROWS",
+		ONCOMMIT_DELETE_ROWS:   "ON COMMIT DELETE ROWS",
+		ONCOMMIT_DROP:          "ON COMMIT DROP",
+	}
+)
+
 func (node IntoClause) Deparse(ctx Context) (*string, error) {
-	panic("Not Implemented")
+	out := make([]string, 0)
+	if node.Rel == nil {
+		return nil, errors.New("into clause must have a relation")
+	}
+
+	if str, err := node.Rel.Deparse(Context_None); err != nil {
+		return nil, err
+	} else {
+		out = append(out, *str)
+	}
+
+	if node.ColNames.Items != nil && len(node.ColNames.Items) > 0 {
+		if names, err := node.ColNames.DeparseList(Context_None); err != nil {
+			return nil, err
+		} else {
+			out = append(out, fmt.Sprintf("(%s)", strings.Join(names, ", ")))
+		}
+	}
+
+	if node.Options.Items != nil && len(node.Options.Items) > 0 {
+		if str, err := deparseRelOptions(node.Options); err != nil {
+			return nil, err
+		} else {
+			out = append(out, fmt.Sprintf("WITH %s", *str))
+		}
+	}
+
+	if action, ok := onCommitActions[node.OnCommit]; !ok {
+		return nil, errors.Errorf("cannot deparse on commit action (%d)", node.OnCommit)
+	} else if action != "" {
+		out = append(out, action)
+	}
+
+	if node.TableSpaceName != nil {
+		out = append(out, fmt.Sprintf(`TABLESPACE "%s"`, *node.TableSpaceName))
+	}
+
+	result := strings.Join(out, " ")
+	return &result, nil
 }
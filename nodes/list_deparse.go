@@ -2,8 +2,12 @@
 
 package pg_query
 
+import (
+	"github.com/juju/errors"
+)
+
 func (node List) Deparse(ctx Context) (*string, error) {
-	panic("use DeparseList for lists")
+	return nil, errors.New("cannot deparse a list directly, use DeparseList for lists")
 }
 
 func (list List) DeparseList(ctx Context) ([]string, error) {
@@ -2,6 +2,18 @@
 
 package pg_query
 
+import (
+	"strings"
+
+	"github.com/juju/errors"
+)
+
 func (node ListenStmt) Deparse(ctx Context) (*string, error) {
-	panic("Not Implemented")
+	if node.Conditionname == nil {
+		return nil, errors.New("listen statement must have a channel")
+	}
+
+	out := []string{"LISTEN", quoteIdentifier(*node.Conditionname)}
+	result := strings.Join(out, " ")
+	return &result, nil
 }
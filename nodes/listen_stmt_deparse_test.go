@@ -0,0 +1,91 @@
+/*
+ * Copyright (c) 2019 Ready Stock
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+package pg_query
+
+import (
+	"testing"
+)
+
+func Test_ListenStmt(t *testing.T) {
+	DoTest(t, DeparseTest{
+		Query:    `LISTEN notify_async1;`,
+		Expected: `LISTEN notify_async1`,
+	})
+}
+
+func Test_UnlistenStmt(t *testing.T) {
+	DoTest(t, DeparseTest{
+		Query:    `UNLISTEN notify_async1;`,
+		Expected: `UNLISTEN notify_async1`,
+	})
+}
+
+func Test_UnlistenStmt_All(t *testing.T) {
+	DoTest(t, DeparseTest{
+		Query:    `UNLISTEN *;`,
+		Expected: `UNLISTEN *`,
+	})
+}
+
+func Test_NotifyStmt(t *testing.T) {
+	DoTest(t, DeparseTest{
+		Query:    `NOTIFY notify_async2;`,
+		Expected: `NOTIFY notify_async2`,
+	})
+}
+
+func Test_NotifyStmt_Payload(t *testing.T) {
+	DoTest(t, DeparseTest{
+		Query:    `NOTIFY notify_async2, 'it''s a payload';`,
+		Expected: `NOTIFY notify_async2, 'it''s a payload'`,
+	})
+}
+
+func Test_DiscardStmt(t *testing.T) {
+	DoTest(t, DeparseTest{
+		Query:    `DISCARD ALL;`,
+		Expected: `DISCARD ALL`,
+	})
+}
+
+func Test_DiscardStmt_Temp(t *testing.T) {
+	DoTest(t, DeparseTest{
+		Query:    `DISCARD TEMPORARY;`,
+		Expected: `DISCARD TEMP`,
+	})
+}
+
+func Test_ListenStmt_QuotedName(t *testing.T) {
+	DoTest(t, DeparseTest{
+		Query:    `LISTEN "Chan"`,
+		Expected: `LISTEN "Chan"`,
+	})
+}
+
+func Test_UnlistenStmt_QuotedName(t *testing.T) {
+	DoTest(t, DeparseTest{
+		Query:    `UNLISTEN "Chan"`,
+		Expected: `UNLISTEN "Chan"`,
+	})
+}
+
+func Test_NotifyStmt_QuotedName(t *testing.T) {
+	DoTest(t, DeparseTest{
+		Query:    `NOTIFY "Chan", 'payload'`,
+		Expected: `NOTIFY "Chan", 'payload'`,
+	})
+}
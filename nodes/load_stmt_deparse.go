@@ -2,6 +2,17 @@
 
 package pg_query
 
+import (
+	"fmt"
+
+	"github.com/juju/errors"
+)
+
 func (node LoadStmt) Deparse(ctx Context) (*string, error) {
-	panic("Not Implemented")
+	if node.Filename == nil {
+		return nil, errors.New("file name cannot be null in load")
+	}
+
+	result := fmt.Sprintf("LOAD %s", quoteLiteral(*node.Filename))
+	return &result, nil
 }
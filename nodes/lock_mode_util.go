@@ -0,0 +1,35 @@
+/*
+ * Copyright (c) 2019 Ready Stock
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+package pg_query
+
+/*
+ * These are the valid values of type LOCKMODE for all the standard lock
+ * methods (both DEFAULT and USER).
+ */
+const (
+	/* NoLock is not a lock mode, but a flag value meaning "don't get a lock" */
+	NoLock = 0
+
+	AccessShareLock          = 1 /* SELECT */
+	RowShareLock             = 2 /* SELECT FOR UPDATE/FOR SHARE */
+	RowExclusiveLock         = 3 /* INSERT, UPDATE, DELETE */
+	ShareUpdateExclusiveLock = 4 /* VACUUM (non-FULL),ANALYZE, CREATE INDEX CONCURRENTLY */
+	ShareLock                = 5 /* CREATE INDEX (WITHOUT CONCURRENTLY) */
+	ShareRowExclusiveLock    = 6 /* like EXCLUSIVE MODE, but allows ROW SHARE */
+	ExclusiveLock            = 7 /* blocks ROW SHARE/SELECT...FOR UPDATE */
+	AccessExclusiveLock      = 8 /* ALTER TABLE, DROP TABLE, VACUUM FULL, and unqualified LOCK TABLE */
+)
@@ -2,6 +2,44 @@
 
 package pg_query
 
+import (
+	"fmt"
+	"strings"
+
+	"github.com/juju/errors"
+)
+
+var (
+	lockModes = map[int]string{
+		AccessShareLock:          "ACCESS SHARE",
+		RowShareLock:             "ROW SHARE",
+		RowExclusiveLock:         "ROW EXCLUSIVE",
+		ShareUpdateExclusiveLock: "SHARE UPDATE EXCLUSIVE",
+		ShareLock:                "SHARE",
+		ShareRowExclusiveLock:    "SHARE ROW EXCLUSIVE",
+		ExclusiveLock:            "EXCLUSIVE",
+		AccessExclusiveLock:      "ACCESS EXCLUSIVE",
+	}
+)
+
 func (node LockStmt) Deparse(ctx Context) (*string, error) {
-	panic("Not Implemented")
+	out := []string{"LOCK TABLE"}
+	if relations, err := node.Relations.DeparseList(Context_None); err != nil {
+		return nil, err
+	} else {
+		out = append(out, strings.Join(relations, ", "))
+	}
+
+	if mode, ok := lockModes[node.Mode]; !ok {
+		return nil, errors.Errorf("cannot deparse lock mode (%d)", node.Mode)
+	} else {
+		out = append(out, fmt.Sprintf("IN %s MODE", mode))
+	}
+
+	if node.Nowait {
+		out = append(out, "NOWAIT")
+	}
+
+	result := strings.Join(out, " ")
+	return &result, nil
 }
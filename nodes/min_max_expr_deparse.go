@@ -2,6 +2,30 @@
 
 package pg_query
 
+import (
+	"fmt"
+	"strings"
+
+	"github.com/juju/errors"
+)
+
+var (
+	minMaxOps = map[MinMaxOp]string{
+		IS_GREATEST: "GREATEST",
+		IS_LEAST:    "LEAST",
+	}
+)
+
 func (node MinMaxExpr) Deparse(ctx Context) (*string, error) {
-	panic("Not Implemented")
+	op, ok := minMaxOps[node.Op]
+	if !ok {
+		return nil, errors.Errorf("cannot deparse min max op (%d)", node.Op)
+	}
+
+	if args, err := node.Args.DeparseList(Context_None); err != nil {
+		return nil, err
+	} else {
+		result := fmt.Sprintf("%s(%s)", op, strings.Join(args, ", "))
+		return &result, nil
+	}
 }
@@ -2,6 +2,16 @@
 
 package pg_query
 
+import (
+	"github.com/juju/errors"
+)
+
+// Deparse returns the row-valued source of the assignment, the targets that
+// share it are grouped together by deparseUpdateTargets.
 func (node MultiAssignRef) Deparse(ctx Context) (*string, error) {
-	panic("Not Implemented")
+	if node.Source == nil {
+		return nil, errors.New("multi assign ref must have a source")
+	}
+
+	return deparseNode(node.Source, Context_None)
 }
@@ -2,6 +2,21 @@
 
 package pg_query
 
+import (
+	"fmt"
+
+	"github.com/juju/errors"
+)
+
 func (node NamedArgExpr) Deparse(ctx Context) (*string, error) {
-	panic("Not Implemented")
+	if node.Name == nil {
+		return nil, errors.New("named argument must have a name")
+	}
+
+	if str, err := deparseNode(node.Arg, Context_None); err != nil {
+		return nil, err
+	} else {
+		result := fmt.Sprintf("%s => %s", quoteIdentifier(*node.Name), *str)
+		return &result, nil
+	}
 }
@@ -2,6 +2,13 @@
 
 package pg_query
 
+import (
+	"fmt"
+)
+
 func (node NextValueExpr) Deparse(ctx Context) (*string, error) {
-	panic("Not Implemented")
+	// Only the OID of the sequence is known, which can still be referenced by
+	// casting it to a relation.
+	result := fmt.Sprintf("pg_catalog.nextval(%d::regclass)", node.Seqid)
+	return &result, nil
 }
@@ -2,10 +2,13 @@ package pg_query
 
 import (
 	"crypto/sha1"
+	"fmt"
 	"hash"
 	"io"
 	"reflect"
 	"strings"
+
+	"github.com/juju/errors"
 )
 
 type FingerprintContext interface {
@@ -83,11 +86,24 @@ type Node interface {
 	Fingerprint(FingerprintContext, Node, string)
 }
 
-func Deparse(node Node) (*string, error) {
+// Deparse returns the SQL text of the node. Nodes that cannot be deparsed
+// result in an error rather than a panic, IsUnsupported reports whether the
+// error was caused by a node that is not supported.
+func Deparse(node Node) (result *string, err error) {
+	// Nodes check the fields they need themselves, this only guards against
+	// malformed trees failing in ways that were not anticipated.
+	defer func() {
+		if r := recover(); r != nil {
+			result, err = nil, errors.Errorf("cannot deparse %T: %v", node, r)
+		}
+	}()
 	return deparseNode(node, Context_None)
 }
 
 func deparseNode(node Node, ctx Context) (*string, error) {
+	if node == nil {
+		return nil, errors.New("cannot deparse a null node")
+	}
 	return node.Deparse(ctx)
 }
 
@@ -101,4 +117,73 @@ func deparseNodeList(nodes []Node, ctx Context) ([]string, error) {
 		}
 	}
 	return out, nil
-}
\ No newline at end of file
+}
+
+// quoteLiteral wraps the provided string in single quotes, escaping any single
+// quotes within it so it can be used as a SQL string constant.
+func quoteLiteral(str string) string {
+	return fmt.Sprintf("'%s'", strings.Replace(str, "'", "''", -1))
+}
+
+// dollarQuote wraps the provided string in dollar quotes, picking a tag that
+// does not occur within the string so that it never needs to be escaped. A
+// trailing dollar sign could form the closing tag too, so that is checked for.
+func dollarQuote(str string) string {
+	delimiter := "$$"
+	for i := 0; strings.Contains(str+"$", delimiter); i++ {
+		if i == 0 {
+			delimiter = "$body$"
+		} else {
+			delimiter = fmt.Sprintf("$body%d$", i)
+		}
+	}
+	return fmt.Sprintf("%s%s%s", delimiter, str, delimiter)
+}
+
+// deparseOperatorName returns the operator named by the provided list, schema
+// qualified operators have to be written using the OPERATOR() syntax.
+func deparseOperatorName(names List) (*string, error) {
+	parts, err := names.DeparseList(Context_Operator)
+	if err != nil {
+		return nil, err
+	}
+
+	switch len(parts) {
+	case 0:
+		return nil, errors.New("operator name cannot be empty")
+	case 1:
+		return &parts[0], nil
+	default:
+		result := fmt.Sprintf("OPERATOR(%s)", strings.Join(parts, "."))
+		return &result, nil
+	}
+}
+
+// quoteIdentifier wraps the provided name in double quotes unless it is a
+// plain lowercase identifier that would be parsed back to the same name. Any
+// keyword that is not unreserved has to be quoted as well.
+func quoteIdentifier(name string) string {
+	if category, ok := keywordCategories[name]; ok && category != UNRESERVED_KEYWORD {
+		return fmt.Sprintf(`"%s"`, name)
+	}
+	return quoteLabel(name)
+}
+
+// quoteLabel is like quoteIdentifier for positions where the grammar accepts
+// any keyword as a name, such as option names, so only names that are not
+// plain lowercase identifiers are quoted.
+func quoteLabel(name string) string {
+	safe := name != ""
+	for i, char := range name {
+		if (char >= 'a' && char <= 'z') || char == '_' || (i > 0 && ((char >= '0' && char <= '9') || char == '$')) {
+			continue
+		}
+		safe = false
+		break
+	}
+
+	if safe {
+		return name
+	}
+	return fmt.Sprintf(`"%s"`, strings.Replace(name, `"`, `""`, -1))
+}
@@ -2,6 +2,20 @@
 
 package pg_query
 
+import (
+	"fmt"
+
+	"github.com/juju/errors"
+)
+
 func (node NotifyStmt) Deparse(ctx Context) (*string, error) {
-	panic("Not Implemented")
+	if node.Conditionname == nil {
+		return nil, errors.New("notify statement must have a channel")
+	}
+
+	result := fmt.Sprintf("NOTIFY %s", quoteIdentifier(*node.Conditionname))
+	if node.Payload != nil {
+		result = fmt.Sprintf("%s, %s", result, quoteLiteral(*node.Payload))
+	}
+	return &result, nil
 }
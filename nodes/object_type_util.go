@@ -0,0 +1,156 @@
+/*
+ * Copyright (c) 2019 Ready Stock
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+package pg_query
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/juju/errors"
+)
+
+var (
+	objectTypeNames = map[ObjectType]string{
+		OBJECT_ACCESS_METHOD:   "ACCESS METHOD",
+		OBJECT_AGGREGATE:       "AGGREGATE",
+		OBJECT_CAST:            "CAST",
+		OBJECT_COLUMN:          "COLUMN",
+		OBJECT_COLLATION:       "COLLATION",
+		OBJECT_CONVERSION:      "CONVERSION",
+		OBJECT_DATABASE:        "DATABASE",
+		OBJECT_DOMAIN:          "DOMAIN",
+		OBJECT_DOMCONSTRAINT:   "CONSTRAINT",
+		OBJECT_EVENT_TRIGGER:   "EVENT TRIGGER",
+		OBJECT_EXTENSION:       "EXTENSION",
+		OBJECT_FDW:             "FOREIGN DATA WRAPPER",
+		OBJECT_FOREIGN_SERVER:  "SERVER",
+		OBJECT_FOREIGN_TABLE:   "FOREIGN TABLE",
+		OBJECT_FUNCTION:        "FUNCTION",
+		OBJECT_INDEX:           "INDEX",
+		OBJECT_LANGUAGE:        "LANGUAGE",
+		OBJECT_LARGEOBJECT:     "LARGE OBJECT",
+		OBJECT_MATVIEW:         "MATERIALIZED VIEW",
+		OBJECT_OPCLASS:         "OPERATOR CLASS",
+		OBJECT_OPERATOR:        "OPERATOR",
+		OBJECT_OPFAMILY:        "OPERATOR FAMILY",
+		OBJECT_POLICY:          "POLICY",
+		OBJECT_PUBLICATION:     "PUBLICATION",
+		OBJECT_ROLE:            "ROLE",
+		OBJECT_RULE:            "RULE",
+		OBJECT_SCHEMA:          "SCHEMA",
+		OBJECT_SEQUENCE:        "SEQUENCE",
+		OBJECT_SUBSCRIPTION:    "SUBSCRIPTION",
+		OBJECT_STATISTIC_EXT:   "STATISTICS",
+		OBJECT_TABCONSTRAINT:   "CONSTRAINT",
+		OBJECT_TABLE:           "TABLE",
+		OBJECT_TABLESPACE:      "TABLESPACE",
+		OBJECT_TRANSFORM:       "TRANSFORM",
+		OBJECT_TRIGGER:         "TRIGGER",
+		OBJECT_TSCONFIGURATION: "TEXT SEARCH CONFIGURATION",
+		OBJECT_TSDICTIONARY:    "TEXT SEARCH DICTIONARY",
+		OBJECT_TSPARSER:        "TEXT SEARCH PARSER",
+		OBJECT_TSTEMPLATE:      "TEXT SEARCH TEMPLATE",
+		OBJECT_TYPE:            "TYPE",
+		OBJECT_VIEW:            "VIEW",
+	}
+)
+
+// deparseObjectType returns the keyword that is used to refer to the given
+// kind of object in statements like COMMENT or ALTER ... OWNER TO.
+func deparseObjectType(objectType ObjectType) (*string, error) {
+	if name, ok := objectTypeNames[objectType]; !ok {
+		return nil, errors.Errorf("cannot deparse object type [%s]", objectType.String())
+	} else {
+		return &name, nil
+	}
+}
+
+// deparseObjectName returns the name of an object as it is referenced by the
+// generic object statements. Relations are given as a range var, while the
+// shape of any other object depends on the type of the object.
+func deparseObjectName(objectType ObjectType, relation *RangeVar, object Node) (*string, error) {
+	if relation != nil {
+		rel, err := deparseNode(*relation, Context_None)
+		if err != nil {
+			return nil, err
+		}
+
+		// Objects that belong to a relation, like triggers, are named relative to
+		// that relation.
+		if object == nil {
+			return rel, nil
+		} else if names, ok := object.(List); !ok {
+			return nil, errors.Errorf("cannot deparse name of object of type %T", object)
+		} else if name, err := names.DeparseList(Context_None); err != nil {
+			return nil, err
+		} else {
+			result := fmt.Sprintf("%s ON %s", strings.Join(name, "."), *rel)
+			return &result, nil
+		}
+	}
+
+	if object == nil {
+		return nil, errors.Errorf("object of type [%s] cannot be null", objectType.String())
+	}
+
+	names, ok := object.(List)
+	if !ok {
+		// Aggregates that take any rows are written with a star instead of their
+		// arguments.
+		if owa, ok := object.(ObjectWithArgs); ok && objectType == OBJECT_AGGREGATE &&
+			!owa.ArgsUnspecified && len(owa.Objargs.Items) == 0 {
+			if name, err := owa.Objname.DeparseList(Context_FuncCall); err != nil {
+				return nil, err
+			} else {
+				result := fmt.Sprintf("%s(*)", strings.Join(name, "."))
+				return &result, nil
+			}
+		}
+		if objectType == OBJECT_OPERATOR {
+			return deparseNode(object, Context_Operator)
+		}
+		return deparseNode(object, Context_None)
+	}
+
+	if names.Items == nil || len(names.Items) == 0 {
+		return nil, errors.Errorf("name of object of type [%s] cannot be empty", objectType.String())
+	}
+
+	items, err := names.DeparseList(Context_None)
+	if err != nil {
+		return nil, err
+	}
+
+	result := ""
+	last := len(items) - 1
+	switch objectType {
+	case OBJECT_CAST:
+		result = fmt.Sprintf("(%s)", strings.Join(items, " AS "))
+	case OBJECT_TRANSFORM:
+		result = fmt.Sprintf("FOR %s LANGUAGE %s", items[0], items[1])
+	case OBJECT_OPCLASS, OBJECT_OPFAMILY:
+		// The access method is stored in front of the name itself.
+		result = fmt.Sprintf("%s USING %s", strings.Join(items[1:], "."), items[0])
+	case OBJECT_DOMCONSTRAINT:
+		result = fmt.Sprintf("%s ON DOMAIN %s", items[last], items[0])
+	case OBJECT_TABCONSTRAINT, OBJECT_POLICY, OBJECT_RULE, OBJECT_TRIGGER:
+		result = fmt.Sprintf("%s ON %s", items[last], strings.Join(items[:last], "."))
+	default:
+		result = strings.Join(items, ".")
+	}
+	return &result, nil
+}
@@ -10,9 +10,16 @@ import (
 func (node ObjectWithArgs) Deparse(ctx Context) (*string, error) {
 	out := make([]string, 0)
 
+	// Operators are named by their symbol, which must not be quoted like the
+	// schema in front of it.
 	objName := make([]string, len(node.Objname.Items))
 	for i, name := range node.Objname.Items {
-		if str, err := name.Deparse(Context_FuncCall); err != nil {
+		nameCtx := Context_FuncCall
+		if ctx == Context_Operator && i == len(node.Objname.Items)-1 {
+			nameCtx = Context_Operator
+		}
+
+		if str, err := name.Deparse(nameCtx); err != nil {
 			return nil, err
 		} else {
 			objName[i] = *str
@@ -21,14 +28,24 @@ func (node ObjectWithArgs) Deparse(ctx Context) (*string, error) {
 
 	args := make([]string, len(node.Objargs.Items))
 	for i, arg := range node.Objargs.Items {
-		if str, err := arg.Deparse(Context_FuncCall); err != nil {
+		// A missing argument type is used for the unused side of prefix and
+		// postfix operators.
+		if arg == nil {
+			args[i] = "NONE"
+		} else if str, err := arg.Deparse(Context_FuncCall); err != nil {
 			return nil, err
 		} else {
 			args[i] = *str
 		}
 	}
 
-	out = append(out, fmt.Sprintf("%s(%s)", strings.Join(objName, "."), strings.Join(args, ", ")))
+	// When the arguments were omitted entirely the name alone must be unique, so
+	// it should not be written with an empty argument list.
+	if node.ArgsUnspecified {
+		out = append(out, strings.Join(objName, "."))
+	} else {
+		out = append(out, fmt.Sprintf("%s(%s)", strings.Join(objName, "."), strings.Join(args, ", ")))
+	}
 
 	result := strings.Join(out, " ")
 	return &result, nil
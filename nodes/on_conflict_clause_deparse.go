@@ -2,6 +2,45 @@
 
 package pg_query
 
+import (
+	"fmt"
+	"strings"
+
+	"github.com/juju/errors"
+)
+
 func (node OnConflictClause) Deparse(ctx Context) (*string, error) {
-	panic("Not Implemented")
+	out := []string{"ON CONFLICT"}
+	if node.Infer != nil {
+		if str, err := node.Infer.Deparse(Context_None); err != nil {
+			return nil, err
+		} else {
+			out = append(out, *str)
+		}
+	}
+
+	switch node.Action {
+	case ONCONFLICT_NOTHING:
+		out = append(out, "DO NOTHING")
+	case ONCONFLICT_UPDATE:
+		out = append(out, "DO UPDATE SET")
+		if str, err := deparseUpdateTargets(node.TargetList); err != nil {
+			return nil, err
+		} else {
+			out = append(out, *str)
+		}
+
+		if node.WhereClause != nil {
+			if str, err := deparseNode(node.WhereClause, Context_None); err != nil {
+				return nil, err
+			} else {
+				out = append(out, fmt.Sprintf("WHERE %s", *str))
+			}
+		}
+	default:
+		return nil, errors.Errorf("cannot deparse on conflict action (%d)", node.Action)
+	}
+
+	result := strings.Join(out, " ")
+	return &result, nil
 }
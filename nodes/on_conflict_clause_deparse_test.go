@@ -0,0 +1,73 @@
+/*
+ * Copyright (c) 2019 Ready Stock
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+package pg_query
+
+import (
+	"testing"
+)
+
+func Test_OnConflictClause_DoNothing(t *testing.T) {
+	DoTest(t, DeparseTest{
+		Query:    `insert into insertconflicttest values(0, 'Crowberry') on conflict (key, fruit) do nothing;`,
+		Expected: `INSERT INTO "insertconflicttest" VALUES (0, 'Crowberry') ON CONFLICT ("key", "fruit") DO NOTHING`,
+	})
+	DoTest(t, DeparseTest{
+		Query:    `insert into insertconflicttest values(0, 'Crowberry') on conflict (lower(fruit) collate "C" text_pattern_ops, key, key) do nothing;`,
+		Expected: `INSERT INTO "insertconflicttest" VALUES (0, 'Crowberry') ON CONFLICT (lower("fruit") COLLATE "C" text_pattern_ops, "key", "key") DO NOTHING`,
+	})
+	DoTest(t, DeparseTest{
+		Query:    `insert into insertconflicttest values (23, 'Blackberry') on conflict (key) where fruit <> 'Lime' do nothing;`,
+		Expected: `INSERT INTO "insertconflicttest" VALUES (23, 'Blackberry') ON CONFLICT ("key") WHERE "fruit" <> 'Lime' DO NOTHING`,
+	})
+	DoTest(t, DeparseTest{
+		Query:    `insert into insertconflicttest default values on conflict do nothing;`,
+		Expected: `INSERT INTO "insertconflicttest" DEFAULT VALUES ON CONFLICT DO NOTHING`,
+	})
+}
+
+func Test_OnConflictClause_DoUpdate(t *testing.T) {
+	DoTest(t, DeparseTest{
+		Query:    `insert into insertconflicttest values (0, 'Bilberry') on conflict (key) do update set fruit = excluded.fruit where insertconflicttest.fruit != 'Lime' returning *;`,
+		Expected: `INSERT INTO "insertconflicttest" VALUES (0, 'Bilberry') ON CONFLICT ("key") DO UPDATE SET fruit = "excluded"."fruit" WHERE "insertconflicttest"."fruit" <> 'Lime' RETURNING *`,
+	})
+	DoTest(t, DeparseTest{
+		Query:    `insert into insertconflicttest values (1, 'Apple') on conflict on constraint pk_key do update set fruit = excluded.fruit, key = excluded.key + 1;`,
+		Expected: `INSERT INTO "insertconflicttest" VALUES (1, 'Apple') ON CONFLICT ON CONSTRAINT pk_key DO UPDATE SET fruit = "excluded"."fruit", key = "excluded"."key" + 1`,
+	})
+	DoTest(t, DeparseTest{
+		Query:    `insert into insertconflicttest AS ict values (6, 'Passionfruit') on conflict (key) do update set fruit = ict.fruit;`,
+		Expected: `INSERT INTO "insertconflicttest" AS ict VALUES (6, 'Passionfruit') ON CONFLICT ("key") DO UPDATE SET fruit = "ict"."fruit"`,
+	})
+}
+
+func Test_OnConflictClause_MultiAssign(t *testing.T) {
+	DoTest(t, DeparseTest{
+		Query:    `insert into insertconflicttest values (1, 'Apple') on conflict (key) do update set (fruit, key) = (excluded.fruit, excluded.key);`,
+		Expected: `INSERT INTO "insertconflicttest" VALUES (1, 'Apple') ON CONFLICT ("key") DO UPDATE SET (fruit, key) = ("excluded"."fruit", "excluded"."key")`,
+	})
+	DoTest(t, DeparseTest{
+		Query:    `UPDATE t SET (a, b) = ROW(1, 2), c = 3, (d, e) = (SELECT 4, 5);`,
+		Expected: `UPDATE "t" SET (a, b) = ROW(1, 2), c = 3, (d, e) = (SELECT 4, 5)`,
+	})
+}
+
+func Test_OnConflictClause_QuotedConstraint(t *testing.T) {
+	DoTest(t, DeparseTest{
+		Query:    `INSERT INTO t (a) VALUES (1) ON CONFLICT ON CONSTRAINT "T_pkey" DO NOTHING`,
+		Expected: `INSERT INTO "t" (a) VALUES (1) ON CONFLICT ON CONSTRAINT "T_pkey" DO NOTHING`,
+	})
+}
@@ -2,6 +2,37 @@
 
 package pg_query
 
+import (
+	"fmt"
+	"github.com/juju/errors"
+	"strings"
+)
+
 func (node PartitionBoundSpec) Deparse(ctx Context) (*string, error) {
-	panic("Not Implemented")
+	out := []string{"FOR VALUES"}
+	switch node.Strategy {
+	case 'l':
+		if datums, err := node.Listdatums.DeparseList(Context_None); err != nil {
+			return nil, err
+		} else {
+			out = append(out, fmt.Sprintf("IN (%s)", strings.Join(datums, ", ")))
+		}
+	case 'r':
+		if lower, err := node.Lowerdatums.DeparseList(Context_None); err != nil {
+			return nil, err
+		} else {
+			out = append(out, fmt.Sprintf("FROM (%s)", strings.Join(lower, ", ")))
+		}
+
+		if upper, err := node.Upperdatums.DeparseList(Context_None); err != nil {
+			return nil, err
+		} else {
+			out = append(out, fmt.Sprintf("TO (%s)", strings.Join(upper, ", ")))
+		}
+	default:
+		return nil, errors.Errorf("cannot deparse partition strategy (%c)", node.Strategy)
+	}
+
+	result := strings.Join(out, " ")
+	return &result, nil
 }
@@ -2,6 +2,31 @@
 
 package pg_query
 
+import (
+	"github.com/juju/errors"
+	"strings"
+)
+
 func (node PartitionCmd) Deparse(ctx Context) (*string, error) {
-	panic("Not Implemented")
+	out := make([]string, 0)
+	if node.Name == nil {
+		return nil, errors.New("partition name cannot be null")
+	}
+
+	if str, err := deparseNode(*node.Name, Context_None); err != nil {
+		return nil, err
+	} else {
+		out = append(out, *str)
+	}
+
+	if node.Bound != nil {
+		if str, err := deparseNode(*node.Bound, Context_None); err != nil {
+			return nil, err
+		} else {
+			out = append(out, *str)
+		}
+	}
+
+	result := strings.Join(out, " ")
+	return &result, nil
 }
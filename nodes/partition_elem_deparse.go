@@ -2,6 +2,49 @@
 
 package pg_query
 
+import (
+	"fmt"
+	"strings"
+
+	"github.com/juju/errors"
+)
+
 func (node PartitionElem) Deparse(ctx Context) (*string, error) {
-	panic("Not Implemented")
+	out := make([]string, 0)
+	if node.Name != nil {
+		if str, err := (String{Str: *node.Name}).Deparse(Context_None); err != nil {
+			return nil, err
+		} else {
+			out = append(out, *str)
+		}
+	} else if node.Expr != nil {
+		if str, err := deparseNode(node.Expr, Context_None); err != nil {
+			return nil, err
+		} else if call, ok := node.Expr.(FuncCall); ok && call.isWindowless() {
+			out = append(out, *str)
+		} else {
+			out = append(out, fmt.Sprintf("(%s)", *str))
+		}
+	} else {
+		return nil, errors.New("partition element must have either a name or an expression")
+	}
+
+	if node.Collation.Items != nil && len(node.Collation.Items) > 0 {
+		if names, err := node.Collation.DeparseList(Context_None); err != nil {
+			return nil, err
+		} else {
+			out = append(out, fmt.Sprintf("COLLATE %s", strings.Join(names, ".")))
+		}
+	}
+
+	if node.Opclass.Items != nil && len(node.Opclass.Items) > 0 {
+		if names, err := node.Opclass.DeparseList(Context_FuncCall); err != nil {
+			return nil, err
+		} else {
+			out = append(out, strings.Join(names, "."))
+		}
+	}
+
+	result := strings.Join(out, " ")
+	return &result, nil
 }
@@ -2,6 +2,21 @@
 
 package pg_query
 
+import (
+	"github.com/juju/errors"
+)
+
 func (node PartitionRangeDatum) Deparse(ctx Context) (*string, error) {
-	panic("Not Implemented")
+	switch node.Kind {
+	case PARTITION_RANGE_DATUM_MINVALUE:
+		result := "MINVALUE"
+		return &result, nil
+	case PARTITION_RANGE_DATUM_MAXVALUE:
+		result := "MAXVALUE"
+		return &result, nil
+	case PARTITION_RANGE_DATUM_VALUE:
+		return deparseNode(node.Value, Context_None)
+	default:
+		return nil, errors.Errorf("cannot deparse partition range datum kind (%d)", node.Kind)
+	}
 }
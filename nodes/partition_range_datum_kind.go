@@ -7,10 +7,10 @@ package pg_query
  *
  * This can be MINVALUE, MAXVALUE or a specific bounded value.
  */
-type PartitionRangeDatumKind uint
+type PartitionRangeDatumKind int
 
 const (
-	PARTITION_RANGE_DATUM_MINVALUE PartitionRangeDatumKind = iota
-	PARTITION_RANGE_DATUM_VALUE
-	PARTITION_RANGE_DATUM_MAXVALUE
+	PARTITION_RANGE_DATUM_MINVALUE PartitionRangeDatumKind = -1 /* less than any other value */
+	PARTITION_RANGE_DATUM_VALUE    PartitionRangeDatumKind = 0  /* a specific (bounded) value */
+	PARTITION_RANGE_DATUM_MAXVALUE PartitionRangeDatumKind = 1  /* greater than any other value */
 )
@@ -2,6 +2,23 @@
 
 package pg_query
 
+import (
+	"fmt"
+	"strings"
+
+	"github.com/juju/errors"
+)
+
 func (node PartitionSpec) Deparse(ctx Context) (*string, error) {
-	panic("Not Implemented")
+	if node.Strategy == nil {
+		return nil, errors.New("partition spec must have a strategy")
+	}
+
+	params, err := node.PartParams.DeparseList(Context_None)
+	if err != nil {
+		return nil, err
+	}
+
+	result := fmt.Sprintf("PARTITION BY %s (%s)", strings.ToUpper(*node.Strategy), strings.Join(params, ", "))
+	return &result, nil
 }
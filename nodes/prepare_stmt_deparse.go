@@ -2,6 +2,34 @@
 
 package pg_query
 
+import (
+	"fmt"
+	"strings"
+
+	"github.com/juju/errors"
+)
+
 func (node PrepareStmt) Deparse(ctx Context) (*string, error) {
-	panic("Not Implemented")
+	if node.Name == nil {
+		return nil, errors.New("prepared statement must have a name")
+	}
+
+	out := []string{"PREPARE", quoteIdentifier(*node.Name)}
+
+	if node.Argtypes.Items != nil && len(node.Argtypes.Items) > 0 {
+		if types, err := node.Argtypes.DeparseList(Context_None); err != nil {
+			return nil, err
+		} else {
+			out = append(out, fmt.Sprintf("(%s)", strings.Join(types, ", ")))
+		}
+	}
+
+	if str, err := deparseNode(node.Query, Context_None); err != nil {
+		return nil, err
+	} else {
+		out = append(out, "AS", *str)
+	}
+
+	result := strings.Join(out, " ")
+	return &result, nil
 }
@@ -0,0 +1,84 @@
+/*
+ * Copyright (c) 2019 Ready Stock
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+package pg_query
+
+import (
+	"testing"
+)
+
+func Test_PrepareStmt(t *testing.T) {
+	DoTest(t, DeparseTest{
+		Query:    `PREPARE q2(text) AS SELECT datname, datistemplate FROM pg_database WHERE datname = $1;`,
+		Expected: `PREPARE q2 (text) AS SELECT "datname", "datistemplate" FROM "pg_database" WHERE "datname" = $1`,
+	})
+}
+
+func Test_PrepareStmt_NoTypes(t *testing.T) {
+	DoTest(t, DeparseTest{
+		Query:    `PREPARE q1 AS SELECT 1 AS a;`,
+		Expected: `PREPARE q1 AS SELECT 1 AS a`,
+	})
+}
+
+func Test_ExecuteStmt(t *testing.T) {
+	DoTest(t, DeparseTest{
+		Query:    `EXECUTE q3('AAAAxx', 5::smallint, 10.5::float, false, 4::bigint);`,
+		Expected: `EXECUTE q3 ('AAAAxx', 5::smallint, 10.5::double precision, false, 4::bigint)`,
+	})
+}
+
+func Test_ExecuteStmt_NoParams(t *testing.T) {
+	DoTest(t, DeparseTest{
+		Query:    `EXECUTE q1;`,
+		Expected: `EXECUTE q1`,
+	})
+}
+
+func Test_DeallocateStmt(t *testing.T) {
+	DoTest(t, DeparseTest{
+		Query:    `DEALLOCATE PREPARE q1;`,
+		Expected: `DEALLOCATE q1`,
+	})
+}
+
+func Test_DeallocateStmt_All(t *testing.T) {
+	DoTest(t, DeparseTest{
+		Query:    `DEALLOCATE ALL;`,
+		Expected: `DEALLOCATE ALL`,
+	})
+}
+
+func Test_PrepareStmt_QuotedName(t *testing.T) {
+	DoTest(t, DeparseTest{
+		Query:    `PREPARE "P" (int) AS SELECT $1`,
+		Expected: `PREPARE "P" (int) AS SELECT $1`,
+	})
+}
+
+func Test_ExecuteStmt_QuotedName(t *testing.T) {
+	DoTest(t, DeparseTest{
+		Query:    `EXECUTE "P" (1)`,
+		Expected: `EXECUTE "P" (1)`,
+	})
+}
+
+func Test_DeallocateStmt_QuotedName(t *testing.T) {
+	DoTest(t, DeparseTest{
+		Query:    `DEALLOCATE "select"`,
+		Expected: `DEALLOCATE "select"`,
+	})
+}
@@ -2,6 +2,100 @@
 
 package pg_query
 
+import (
+	"fmt"
+	"strings"
+
+	"github.com/juju/errors"
+)
+
 func (node RangeFunction) Deparse(ctx Context) (*string, error) {
-	panic("Not Implemented")
+	out := make([]string, 0)
+	if node.Lateral {
+		out = append(out, "LATERAL")
+	}
+
+	// Each function is stored as a pair of the function call and the column
+	// definitions that were given for it within ROWS FROM.
+	functions := make([]string, len(node.Functions.Items))
+	for i, item := range node.Functions.Items {
+		pair, ok := item.(List)
+		if !ok || len(pair.Items) != 2 {
+			return nil, errors.New("range function must be a pair of function and column definitions")
+		}
+
+		str, err := deparseRangeFunctionItem(pair.Items[0])
+		if err != nil {
+			return nil, err
+		}
+		functions[i] = *str
+
+		if columns, ok := pair.Items[1].(List); ok && len(columns.Items) > 0 {
+			if defs, err := columns.DeparseList(Context_None); err != nil {
+				return nil, err
+			} else {
+				functions[i] = fmt.Sprintf("%s AS (%s)", functions[i], strings.Join(defs, ", "))
+			}
+		}
+	}
+
+	if node.IsRowsfrom {
+		out = append(out, fmt.Sprintf("ROWS FROM (%s)", strings.Join(functions, ", ")))
+	} else {
+		out = append(out, functions...)
+	}
+
+	if node.Ordinality {
+		out = append(out, "WITH ORDINALITY")
+	}
+
+	if node.Alias != nil {
+		if str, err := deparseNode(*node.Alias, Context_None); err != nil {
+			return nil, err
+		} else {
+			out = append(out, *str)
+		}
+	}
+
+	// Functions returning records need a column definition list, which has to
+	// follow AS when there is no alias.
+	if node.Coldeflist.Items != nil && len(node.Coldeflist.Items) > 0 {
+		if defs, err := node.Coldeflist.DeparseList(Context_None); err != nil {
+			return nil, err
+		} else if node.Alias == nil {
+			out = append(out, fmt.Sprintf("AS (%s)", strings.Join(defs, ", ")))
+		} else {
+			out = append(out, fmt.Sprintf("(%s)", strings.Join(defs, ", ")))
+		}
+	}
+
+	result := strings.Join(out, " ")
+	return &result, nil
+}
+
+// deparseRangeFunctionItem deparses a function in FROM, besides function calls
+// the grammar only accepts expressions like CAST(...) or COALESCE(...) that are
+// written like one. Casts are therefore always written with CAST.
+func deparseRangeFunctionItem(item Node) (*string, error) {
+	cast, ok := item.(TypeCast)
+	if !ok {
+		return deparseNode(item, Context_None)
+	}
+
+	if cast.TypeName == nil {
+		return nil, errors.New("typename cannot be null in typecast")
+	}
+
+	arg, err := deparseNode(cast.Arg, Context_None)
+	if err != nil {
+		return nil, err
+	}
+
+	typeName, err := cast.TypeName.Deparse(Context_None)
+	if err != nil {
+		return nil, err
+	}
+
+	result := fmt.Sprintf("CAST(%s AS %s)", *arg, *typeName)
+	return &result, nil
 }
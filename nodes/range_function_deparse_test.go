@@ -0,0 +1,133 @@
+/*
+ * Copyright (c) 2019 Ready Stock
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+package pg_query
+
+import (
+	"testing"
+)
+
+func Test_RangeFunction(t *testing.T) {
+	DoTest(t, DeparseTest{
+		Query:    `SELECT * FROM generate_series(1, 3) g;`,
+		Expected: `SELECT * FROM generate_series(1, 3) g`,
+	})
+}
+
+func Test_RangeFunction_LateralOrdinality(t *testing.T) {
+	DoTest(t, DeparseTest{
+		Query:    `SELECT * FROM t, LATERAL unnest(t.a) WITH ORDINALITY AS u(val, idx);`,
+		Expected: `SELECT * FROM "t", LATERAL unnest("t"."a") WITH ORDINALITY u ("val", "idx")`,
+	})
+}
+
+func Test_RangeFunction_ColumnDefinitions(t *testing.T) {
+	DoTest(t, DeparseTest{
+		Query:    `SELECT * FROM jsonb_to_recordset(j) AS x(a int, b text);`,
+		Expected: `SELECT * FROM jsonb_to_recordset("j") x (a int, b text)`,
+	})
+}
+
+func Test_RangeFunction_ColumnDefinitionsWithoutAlias(t *testing.T) {
+	DoTest(t, DeparseTest{
+		Query:    `SELECT * FROM json_to_record(j) AS (a int, b text);`,
+		Expected: `SELECT * FROM json_to_record("j") AS (a int, b text)`,
+	})
+}
+
+func Test_RangeFunction_RowsFrom(t *testing.T) {
+	DoTest(t, DeparseTest{
+		Query:    `SELECT * FROM ROWS FROM (foot(1) AS (fooid int, f2 int), generate_series(1, 2)) WITH ORDINALITY AS z(a, b, ord);`,
+		Expected: `SELECT * FROM ROWS FROM (foot(1) AS (fooid int, f2 int), generate_series(1, 2)) WITH ORDINALITY z ("a", "b", "ord")`,
+	})
+}
+
+func Test_RangeSubselect(t *testing.T) {
+	DoTest(t, DeparseTest{
+		Query:    `SELECT * FROM (SELECT 1 AS a) s;`,
+		Expected: `SELECT * FROM (SELECT 1 AS a) s`,
+	})
+}
+
+func Test_RangeSubselect_LateralAlias(t *testing.T) {
+	DoTest(t, DeparseTest{
+		Query:    `SELECT * FROM t, LATERAL (SELECT t.a, t.b) AS s(x, y);`,
+		Expected: `SELECT * FROM "t", LATERAL (SELECT "t"."a", "t"."b") s ("x", "y")`,
+	})
+}
+
+func Test_RangeTableSample(t *testing.T) {
+	DoTest(t, DeparseTest{
+		Query:    `SELECT * FROM test_tablesample t TABLESAMPLE SYSTEM (50) REPEATABLE (42);`,
+		Expected: `SELECT * FROM "test_tablesample" t TABLESAMPLE system (50) REPEATABLE (42)`,
+	})
+}
+
+func Test_RangeTableSample_Bernoulli(t *testing.T) {
+	DoTest(t, DeparseTest{
+		Query:    `SELECT id FROM test_tablesample TABLESAMPLE bernoulli (5.5);`,
+		Expected: `SELECT "id" FROM "test_tablesample" TABLESAMPLE bernoulli (5.5)`,
+	})
+}
+
+func Test_RangeTableFunc(t *testing.T) {
+	DoTest(t, DeparseTest{
+		Query:    `SELECT * FROM xmldata, XMLTABLE('//ROWS/ROW' PASSING data COLUMNS id int PATH '@id', _id FOR ORDINALITY, country_name text PATH 'COUNTRY_NAME' NOT NULL, size float PATH 'SIZE' DEFAULT 0) x;`,
+		Expected: `SELECT * FROM "xmldata", XMLTABLE('//ROWS/ROW' PASSING "data" COLUMNS id int PATH '@id', _id FOR ORDINALITY, country_name text PATH 'COUNTRY_NAME' NOT NULL, size double precision PATH 'SIZE' DEFAULT 0) x`,
+	})
+}
+
+func Test_RangeTableFunc_Namespaces(t *testing.T) {
+	DoTest(t, DeparseTest{
+		Query:    `SELECT * FROM LATERAL XMLTABLE(XMLNAMESPACES('http://x.y' AS zz, DEFAULT 'http://z'), '/zz:rows/zz:row' PASSING (SELECT data FROM d) COLUMNS a int PATH 'zz:a') AS t;`,
+		Expected: `SELECT * FROM LATERAL XMLTABLE(XMLNAMESPACES('http://x.y' AS zz, DEFAULT 'http://z'), '/zz:rows/zz:row' PASSING (SELECT "data" FROM "d") COLUMNS a int PATH 'zz:a') t`,
+	})
+}
+
+func Test_RangeFunction_Cast(t *testing.T) {
+	DoTest(t, DeparseTest{
+		Query:    `SELECT * FROM CAST(1 + 2 AS int4) AS x`,
+		Expected: `SELECT * FROM CAST(1 + 2 AS int4) x`,
+	})
+}
+
+func Test_RangeFunction_RowsFromCast(t *testing.T) {
+	DoTest(t, DeparseTest{
+		Query:    `SELECT * FROM ROWS FROM (CAST(1 AS text), generate_series(1, 2))`,
+		Expected: `SELECT * FROM ROWS FROM (CAST(1 AS text), generate_series(1, 2))`,
+	})
+}
+
+func Test_RangeTableFunc_QuotedColumns(t *testing.T) {
+	DoTest(t, DeparseTest{
+		Query:    `SELECT * FROM XMLTABLE('/rows/row' PASSING x COLUMNS "Id" int PATH '@id', "select" text, "Ord" FOR ORDINALITY) AS t`,
+		Expected: `SELECT * FROM XMLTABLE('/rows/row' PASSING "x" COLUMNS "Id" int PATH '@id', "select" text, "Ord" FOR ORDINALITY) t`,
+	})
+}
+
+func Test_RangeTableFunc_QuotedNamespace(t *testing.T) {
+	DoTest(t, DeparseTest{
+		Query:    `SELECT * FROM XMLTABLE(XMLNAMESPACES('http://x' AS "X"), '/X:row' PASSING x COLUMNS a int) AS t`,
+		Expected: `SELECT * FROM XMLTABLE(XMLNAMESPACES('http://x' AS "X"), '/X:row' PASSING "x" COLUMNS a int) t`,
+	})
+}
+
+func Test_RangeVar_QuotedAlias(t *testing.T) {
+	DoTest(t, DeparseTest{
+		Query:    `SELECT * FROM t AS "T" ("A", b), generate_series(1, 2) AS "select"`,
+		Expected: `SELECT * FROM "t" "T" ("A", "b"), generate_series(1, 2) "select"`,
+	})
+}
@@ -2,6 +2,31 @@
 
 package pg_query
 
+import (
+	"fmt"
+	"strings"
+)
+
 func (node RangeSubselect) Deparse(ctx Context) (*string, error) {
-	panic("Not Implemented")
+	out := make([]string, 0)
+	if node.Lateral {
+		out = append(out, "LATERAL")
+	}
+
+	if str, err := deparseNode(node.Subquery, Context_None); err != nil {
+		return nil, err
+	} else {
+		out = append(out, fmt.Sprintf("(%s)", *str))
+	}
+
+	if node.Alias != nil {
+		if str, err := deparseNode(*node.Alias, Context_None); err != nil {
+			return nil, err
+		} else {
+			out = append(out, *str)
+		}
+	}
+
+	result := strings.Join(out, " ")
+	return &result, nil
 }
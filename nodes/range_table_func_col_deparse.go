@@ -2,6 +2,54 @@
 
 package pg_query
 
+import (
+	"strings"
+
+	"github.com/juju/errors"
+)
+
 func (node RangeTableFuncCol) Deparse(ctx Context) (*string, error) {
-	panic("Not Implemented")
+	if node.Colname == nil {
+		return nil, errors.New("table function column must have a name")
+	}
+
+	out := []string{quoteIdentifier(*node.Colname)}
+	if node.ForOrdinality {
+		out = append(out, "FOR ORDINALITY")
+		result := strings.Join(out, " ")
+		return &result, nil
+	}
+
+	if node.TypeName == nil {
+		return nil, errors.New("table function column must have a type")
+	}
+
+	if str, err := deparseNode(*node.TypeName, Context_None); err != nil {
+		return nil, err
+	} else {
+		out = append(out, *str)
+	}
+
+	if node.Colexpr != nil {
+		if str, err := deparseNode(node.Colexpr, Context_None); err != nil {
+			return nil, err
+		} else {
+			out = append(out, "PATH", *str)
+		}
+	}
+
+	if node.Coldefexpr != nil {
+		if str, err := deparseNode(node.Coldefexpr, Context_None); err != nil {
+			return nil, err
+		} else {
+			out = append(out, "DEFAULT", *str)
+		}
+	}
+
+	if node.IsNotNull {
+		out = append(out, "NOT NULL")
+	}
+
+	result := strings.Join(out, " ")
+	return &result, nil
 }
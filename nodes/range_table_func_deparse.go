@@ -2,6 +2,86 @@
 
 package pg_query
 
+import (
+	"fmt"
+	"strings"
+
+	"github.com/juju/errors"
+)
+
 func (node RangeTableFunc) Deparse(ctx Context) (*string, error) {
-	panic("Not Implemented")
+	out := make([]string, 0)
+	if node.Lateral {
+		out = append(out, "LATERAL")
+	}
+
+	args := make([]string, 0)
+	if node.Namespaces.Items != nil && len(node.Namespaces.Items) > 0 {
+		// A namespace without a name is the default namespace.
+		namespaces := make([]string, len(node.Namespaces.Items))
+		for i, item := range node.Namespaces.Items {
+			namespace, ok := item.(ResTarget)
+			if !ok {
+				return nil, errors.Errorf("invalid xml namespace: %T", item)
+			}
+			if namespace.Name == nil {
+				if str, err := deparseNode(namespace.Val, Context_None); err != nil {
+					return nil, err
+				} else {
+					namespaces[i] = fmt.Sprintf("DEFAULT %s", *str)
+				}
+			} else if str, err := deparseNode(namespace, Context_Select); err != nil {
+				return nil, err
+			} else {
+				namespaces[i] = *str
+			}
+		}
+		args = append(args, fmt.Sprintf("XMLNAMESPACES(%s)", strings.Join(namespaces, ", ")))
+	}
+
+	rowExpr, err := deparseTableFuncExpr(node.Rowexpr)
+	if err != nil {
+		return nil, err
+	}
+
+	docExpr, err := deparseTableFuncExpr(node.Docexpr)
+	if err != nil {
+		return nil, err
+	}
+
+	columns, err := node.Columns.DeparseList(Context_None)
+	if err != nil {
+		return nil, err
+	}
+
+	args = append(args, fmt.Sprintf("%s PASSING %s COLUMNS %s", *rowExpr, *docExpr, strings.Join(columns, ", ")))
+	out = append(out, fmt.Sprintf("XMLTABLE(%s)", strings.Join(args, ", ")))
+
+	if node.Alias != nil {
+		if str, err := deparseNode(*node.Alias, Context_None); err != nil {
+			return nil, err
+		} else {
+			out = append(out, *str)
+		}
+	}
+
+	result := strings.Join(out, " ")
+	return &result, nil
+}
+
+// deparseTableFuncExpr returns the row or document expression of a table
+// function, the grammar only accepts simple expressions without parentheses.
+func deparseTableFuncExpr(expr Node) (*string, error) {
+	str, err := deparseNode(expr, Context_None)
+	if err != nil {
+		return nil, err
+	}
+
+	switch expr.(type) {
+	case A_Const, ColumnRef, ParamRef, FuncCall, SubLink:
+		return str, nil
+	default:
+		result := fmt.Sprintf("(%s)", *str)
+		return &result, nil
+	}
 }
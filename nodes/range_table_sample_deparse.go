@@ -2,6 +2,38 @@
 
 package pg_query
 
+import (
+	"fmt"
+	"strings"
+)
+
 func (node RangeTableSample) Deparse(ctx Context) (*string, error) {
-	panic("Not Implemented")
+	out := make([]string, 0)
+	if str, err := deparseNode(node.Relation, Context_None); err != nil {
+		return nil, err
+	} else {
+		out = append(out, *str)
+	}
+
+	method, err := node.Method.DeparseList(Context_FuncCall)
+	if err != nil {
+		return nil, err
+	}
+
+	if args, err := node.Args.DeparseList(Context_None); err != nil {
+		return nil, err
+	} else {
+		out = append(out, fmt.Sprintf("TABLESAMPLE %s (%s)", strings.Join(method, "."), strings.Join(args, ", ")))
+	}
+
+	if node.Repeatable != nil {
+		if str, err := deparseNode(node.Repeatable, Context_None); err != nil {
+			return nil, err
+		} else {
+			out = append(out, fmt.Sprintf("REPEATABLE (%s)", *str))
+		}
+	}
+
+	result := strings.Join(out, " ")
+	return &result, nil
 }
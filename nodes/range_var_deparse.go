@@ -5,9 +5,15 @@ package pg_query
 import (
 	"fmt"
 	"strings"
+
+	"github.com/juju/errors"
 )
 
 func (node RangeVar) Deparse(ctx Context) (*string, error) {
+	if node.Relname == nil {
+		return nil, errors.New("relation name cannot be null")
+	}
+
 	out := make([]string, 0)
 	if !node.Inh {
 		out = append(out, "ONLY")
@@ -30,3 +36,13 @@ func (node RangeVar) Deparse(ctx Context) (*string, error) {
 	result := strings.Join(out, " ")
 	return &result, nil
 }
+
+func (node RangeVar) relPersistence() *string {
+	t, u := "TEMPORARY", "UNLOGGED"
+	if string(node.Relpersistence) == "t" {
+		return &t
+	} else if string(node.Relpersistence) == "u" {
+		return &u
+	}
+	return nil
+}
@@ -2,6 +2,30 @@
 
 package pg_query
 
+import (
+	"github.com/juju/errors"
+	"strings"
+)
+
 func (node ReassignOwnedStmt) Deparse(ctx Context) (*string, error) {
-	panic("Not Implemented")
+	out := []string{"REASSIGN OWNED BY"}
+
+	if roles, err := node.Roles.DeparseList(Context_None); err != nil {
+		return nil, err
+	} else {
+		out = append(out, strings.Join(roles, ", "))
+	}
+
+	if node.Newrole == nil {
+		return nil, errors.New("new role of reassign owned statement cannot be null")
+	}
+
+	if str, err := deparseNode(*node.Newrole, Context_None); err != nil {
+		return nil, err
+	} else {
+		out = append(out, "TO", *str)
+	}
+
+	result := strings.Join(out, " ")
+	return &result, nil
 }
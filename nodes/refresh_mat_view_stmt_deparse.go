@@ -2,6 +2,32 @@
 
 package pg_query
 
+import (
+	"strings"
+
+	"github.com/juju/errors"
+)
+
 func (node RefreshMatViewStmt) Deparse(ctx Context) (*string, error) {
-	panic("Not Implemented")
+	out := []string{"REFRESH MATERIALIZED VIEW"}
+	if node.Concurrent {
+		out = append(out, "CONCURRENTLY")
+	}
+
+	if node.Relation == nil {
+		return nil, errors.New("refresh materialized view statement must have a relation")
+	}
+
+	if str, err := node.Relation.Deparse(Context_None); err != nil {
+		return nil, err
+	} else {
+		out = append(out, *str)
+	}
+
+	if node.SkipData {
+		out = append(out, "WITH NO DATA")
+	}
+
+	result := strings.Join(out, " ")
+	return &result, nil
 }
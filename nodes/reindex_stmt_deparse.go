@@ -2,6 +2,47 @@
 
 package pg_query
 
+import (
+	"strings"
+
+	"github.com/juju/errors"
+)
+
+var (
+	reindexObjectTypes = map[ReindexObjectType]string{
+		REINDEX_OBJECT_INDEX:    "INDEX",
+		REINDEX_OBJECT_TABLE:    "TABLE",
+		REINDEX_OBJECT_SCHEMA:   "SCHEMA",
+		REINDEX_OBJECT_SYSTEM:   "SYSTEM",
+		REINDEX_OBJECT_DATABASE: "DATABASE",
+	}
+)
+
 func (node ReindexStmt) Deparse(ctx Context) (*string, error) {
-	panic("Not Implemented")
+	out := []string{"REINDEX"}
+	if node.Options&REINDEXOPT_VERBOSE != 0 {
+		out = append(out, "(VERBOSE)")
+	}
+
+	if kind, ok := reindexObjectTypes[node.Kind]; !ok {
+		return nil, errors.Errorf("cannot deparse reindex object type (%d)", node.Kind)
+	} else {
+		out = append(out, kind)
+	}
+
+	// Indexes and tables are relations, everything else is referenced by name.
+	if node.Relation != nil {
+		if str, err := deparseNode(*node.Relation, Context_None); err != nil {
+			return nil, err
+		} else {
+			out = append(out, *str)
+		}
+	} else if node.Name != nil {
+		out = append(out, quoteIdentifier(*node.Name))
+	} else {
+		return nil, errors.New("reindex statement must have a relation or a name")
+	}
+
+	result := strings.Join(out, " ")
+	return &result, nil
 }
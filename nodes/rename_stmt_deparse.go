@@ -2,6 +2,101 @@
 
 package pg_query
 
+import (
+	"strings"
+
+	"github.com/juju/errors"
+)
+
 func (node RenameStmt) Deparse(ctx Context) (*string, error) {
-	panic("Not Implemented")
+	out := []string{"ALTER"}
+
+	// Columns, attributes and constraints are renamed by altering the relation
+	// that they belong to.
+	objectType := node.RenameType
+	switch node.RenameType {
+	case OBJECT_COLUMN, OBJECT_ATTRIBUTE:
+		objectType = node.RelationType
+	case OBJECT_TABCONSTRAINT:
+		objectType = OBJECT_TABLE
+	case OBJECT_DOMCONSTRAINT:
+		objectType = OBJECT_DOMAIN
+	}
+
+	if str, err := deparseObjectType(objectType); err != nil {
+		return nil, err
+	} else {
+		out = append(out, *str)
+	}
+
+	if node.MissingOk {
+		out = append(out, "IF EXISTS")
+	}
+
+	switch node.RenameType {
+	case OBJECT_DATABASE, OBJECT_ROLE, OBJECT_SCHEMA, OBJECT_TABLESPACE:
+		if node.Subname == nil {
+			return nil, errors.Errorf("name cannot be null when renaming object type [%s]", node.RenameType.String())
+		}
+		out = append(out, quoteIdentifier(*node.Subname))
+	case OBJECT_POLICY, OBJECT_RULE, OBJECT_TRIGGER:
+		if node.Subname == nil || node.Relation == nil {
+			return nil, errors.Errorf("name cannot be null when renaming object type [%s]", node.RenameType.String())
+		}
+
+		if str, err := deparseNode(*node.Relation, Context_None); err != nil {
+			return nil, err
+		} else {
+			out = append(out, quoteIdentifier(*node.Subname), "ON", *str)
+		}
+	case OBJECT_ATTRIBUTE:
+		if node.Relation == nil {
+			return nil, errors.New("relation cannot be null when renaming an attribute")
+		}
+
+		// Composite types are not inheritable, so their name is never marked as
+		// inherited. Treat it as such so that ONLY is not prepended to it.
+		relation := *node.Relation
+		relation.Inh = true
+		if str, err := deparseNode(relation, Context_None); err != nil {
+			return nil, err
+		} else {
+			out = append(out, *str)
+		}
+	default:
+		if str, err := deparseObjectName(objectType, node.Relation, node.Object); err != nil {
+			return nil, err
+		} else {
+			out = append(out, *str)
+		}
+	}
+
+	out = append(out, "RENAME")
+	switch node.RenameType {
+	case OBJECT_COLUMN, OBJECT_ATTRIBUTE, OBJECT_TABCONSTRAINT, OBJECT_DOMCONSTRAINT:
+		if node.Subname == nil {
+			return nil, errors.Errorf("name cannot be null when renaming object type [%s]", node.RenameType.String())
+		}
+	}
+
+	switch node.RenameType {
+	case OBJECT_COLUMN:
+		out = append(out, "COLUMN", quoteIdentifier(*node.Subname))
+	case OBJECT_ATTRIBUTE:
+		out = append(out, "ATTRIBUTE", quoteIdentifier(*node.Subname))
+	case OBJECT_TABCONSTRAINT, OBJECT_DOMCONSTRAINT:
+		out = append(out, "CONSTRAINT", quoteIdentifier(*node.Subname))
+	}
+
+	if node.Newname == nil {
+		return nil, errors.New("new name cannot be null in rename")
+	}
+	out = append(out, "TO", quoteIdentifier(*node.Newname))
+
+	if node.Behavior == DROP_CASCADE {
+		out = append(out, "CASCADE")
+	}
+
+	result := strings.Join(out, " ")
+	return &result, nil
 }
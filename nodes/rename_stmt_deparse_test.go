@@ -0,0 +1,189 @@
+/*
+ * Copyright (c) 2019 Ready Stock
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+package pg_query
+
+import (
+	"testing"
+)
+
+func Test_Rename_Table(t *testing.T) {
+	DoTest(t, DeparseTest{
+		Query:    `ALTER TABLE IF EXISTS ONLY public.t RENAME TO u;`,
+		Expected: `ALTER TABLE IF EXISTS ONLY "public"."t" RENAME TO u`,
+	})
+}
+
+func Test_Rename_Column(t *testing.T) {
+	DoTest(t, DeparseTest{
+		Query:    `ALTER TABLE t RENAME COLUMN a TO b;`,
+		Expected: `ALTER TABLE "t" RENAME COLUMN a TO b`,
+	})
+}
+
+func Test_Rename_ViewColumn(t *testing.T) {
+	DoTest(t, DeparseTest{
+		Query:    `ALTER MATERIALIZED VIEW mv RENAME a TO b;`,
+		Expected: `ALTER MATERIALIZED VIEW "mv" RENAME COLUMN a TO b`,
+	})
+}
+
+func Test_Rename_Constraint(t *testing.T) {
+	DoTest(t, DeparseTest{
+		Query:    `ALTER TABLE t RENAME CONSTRAINT t_check TO t_positive;`,
+		Expected: `ALTER TABLE "t" RENAME CONSTRAINT t_check TO t_positive`,
+	})
+}
+
+func Test_Rename_Attribute(t *testing.T) {
+	DoTest(t, DeparseTest{
+		Query:    `ALTER TYPE public.pair RENAME ATTRIBUTE x TO y CASCADE;`,
+		Expected: `ALTER TYPE "public"."pair" RENAME ATTRIBUTE x TO y CASCADE`,
+	})
+}
+
+func Test_Rename_Trigger(t *testing.T) {
+	DoTest(t, DeparseTest{
+		Query:    `ALTER TRIGGER tr ON public.t RENAME TO tr2;`,
+		Expected: `ALTER TRIGGER tr ON "public"."t" RENAME TO tr2`,
+	})
+}
+
+func Test_Rename_Policy(t *testing.T) {
+	DoTest(t, DeparseTest{
+		Query:    `ALTER POLICY p ON t RENAME TO p2;`,
+		Expected: `ALTER POLICY p ON "t" RENAME TO p2`,
+	})
+}
+
+func Test_Rename_Database(t *testing.T) {
+	DoTest(t, DeparseTest{
+		Query:    `ALTER DATABASE a RENAME TO b;`,
+		Expected: `ALTER DATABASE a RENAME TO b`,
+	})
+}
+
+func Test_Rename_Role(t *testing.T) {
+	DoTest(t, DeparseTest{
+		Query:    `ALTER ROLE a RENAME TO b;`,
+		Expected: `ALTER ROLE a RENAME TO b`,
+	})
+}
+
+func Test_Rename_Schema(t *testing.T) {
+	DoTest(t, DeparseTest{
+		Query:    `ALTER SCHEMA a RENAME TO b;`,
+		Expected: `ALTER SCHEMA a RENAME TO b`,
+	})
+}
+
+func Test_Rename_Type(t *testing.T) {
+	DoTest(t, DeparseTest{
+		Query:    `ALTER TYPE public.pair RENAME TO couple;`,
+		Expected: `ALTER TYPE "public"."pair" RENAME TO couple`,
+	})
+}
+
+func Test_Rename_Domain(t *testing.T) {
+	DoTest(t, DeparseTest{
+		Query:    `ALTER DOMAIN posint RENAME CONSTRAINT c TO d;`,
+		Expected: `ALTER DOMAIN "posint" RENAME CONSTRAINT c TO d`,
+	})
+}
+
+func Test_Rename_Function(t *testing.T) {
+	DoTest(t, DeparseTest{
+		Query:    `ALTER FUNCTION public.f(int, text) RENAME TO g;`,
+		Expected: `ALTER FUNCTION public.f(int, text) RENAME TO g`,
+	})
+}
+
+func Test_Rename_Aggregate(t *testing.T) {
+	DoTest(t, DeparseTest{
+		Query:    `ALTER AGGREGATE cnt(*) RENAME TO count_all;`,
+		Expected: `ALTER AGGREGATE cnt(*) RENAME TO count_all`,
+	})
+}
+
+func Test_Rename_OperatorFamily(t *testing.T) {
+	DoTest(t, DeparseTest{
+		Query:    `ALTER OPERATOR FAMILY alt_opf1 USING hash RENAME TO alt_opf2;`,
+		Expected: `ALTER OPERATOR FAMILY "alt_opf1" USING "hash" RENAME TO alt_opf2`,
+	})
+}
+
+func Test_Rename_Server(t *testing.T) {
+	DoTest(t, DeparseTest{
+		Query:    `ALTER SERVER s RENAME TO s2;`,
+		Expected: `ALTER SERVER "s" RENAME TO s2`,
+	})
+}
+
+func Test_Rename_ForeignDataWrapper(t *testing.T) {
+	DoTest(t, DeparseTest{
+		Query:    `ALTER FOREIGN DATA WRAPPER w RENAME TO w2;`,
+		Expected: `ALTER FOREIGN DATA WRAPPER "w" RENAME TO w2`,
+	})
+}
+
+func Test_Rename_TextSearchConfiguration(t *testing.T) {
+	DoTest(t, DeparseTest{
+		Query:    `ALTER TEXT SEARCH CONFIGURATION alt_ts_conf1 RENAME TO alt_ts_conf2;`,
+		Expected: `ALTER TEXT SEARCH CONFIGURATION "alt_ts_conf1" RENAME TO alt_ts_conf2`,
+	})
+}
+
+func Test_Rename_Statistics(t *testing.T) {
+	DoTest(t, DeparseTest{
+		Query:    `ALTER STATISTICS s1 RENAME TO s2;`,
+		Expected: `ALTER STATISTICS "s1" RENAME TO s2`,
+	})
+}
+
+func Test_RenameStmt_QuotedColumn(t *testing.T) {
+	DoTest(t, DeparseTest{
+		Query:    `ALTER TABLE t RENAME COLUMN "A" TO "B"`,
+		Expected: `ALTER TABLE "t" RENAME COLUMN "A" TO "B"`,
+	})
+}
+
+func Test_RenameStmt_QuotedSchema(t *testing.T) {
+	DoTest(t, DeparseTest{
+		Query:    `ALTER SCHEMA "S" RENAME TO "S2"`,
+		Expected: `ALTER SCHEMA "S" RENAME TO "S2"`,
+	})
+}
+
+func Test_RenameStmt_KeywordNames(t *testing.T) {
+	DoTest(t, DeparseTest{
+		Query:    `ALTER TABLE t RENAME CONSTRAINT "check" TO "select"`,
+		Expected: `ALTER TABLE "t" RENAME CONSTRAINT "check" TO "select"`,
+	})
+}
+
+func Test_RenameStmt_QuotedTrigger(t *testing.T) {
+	DoTest(t, DeparseTest{
+		Query:    `ALTER TRIGGER "Trg" ON t RENAME TO "user"`,
+		Expected: `ALTER TRIGGER "Trg" ON "t" RENAME TO "user"`,
+	})
+}
+
+func Test_RenameStmt_QuotedDatabase(t *testing.T) {
+	DoTest(t, DeparseTest{
+		Query:    `ALTER DATABASE "Db" RENAME TO "Db-2"`,
+		Expected: `ALTER DATABASE "Db" RENAME TO "Db-2"`,
+	})
+}
@@ -2,6 +2,27 @@
 
 package pg_query
 
+import (
+	"fmt"
+	"github.com/juju/errors"
+)
+
 func (node ReplicaIdentityStmt) Deparse(ctx Context) (*string, error) {
-	panic("Not Implemented")
+	result := ""
+	switch node.IdentityType {
+	case 'd':
+		result = "DEFAULT"
+	case 'f':
+		result = "FULL"
+	case 'n':
+		result = "NOTHING"
+	case 'i':
+		if node.Name == nil {
+			return nil, errors.New("index name cannot be null in replica identity")
+		}
+		result = fmt.Sprintf("USING INDEX %s", quoteIdentifier(*node.Name))
+	default:
+		return nil, errors.Errorf("cannot deparse replica identity type (%c)", node.IdentityType)
+	}
+	return &result, nil
 }
@@ -10,7 +10,7 @@ import (
 func (node ResTarget) Deparse(ctx Context) (*string, error) {
 	switch ctx {
 	case Context_None:
-		return node.Name, nil
+		return node.deparseName()
 	case Context_Select:
 		out := make([]string, 0)
 		if str, err := deparseNode(node.Val, Context_None); err != nil {
@@ -21,7 +21,7 @@ func (node ResTarget) Deparse(ctx Context) (*string, error) {
 
 		if node.Name != nil && len(*node.Name) > 0 {
 			out = append(out, "AS")
-			out = append(out, *node.Name)
+			out = append(out, quoteLabel(*node.Name))
 		}
 		result := strings.Join(out, " ")
 		return &result, nil
@@ -30,7 +30,11 @@ func (node ResTarget) Deparse(ctx Context) (*string, error) {
 		if node.Name == nil || len(*node.Name) == 0 {
 			return nil, errors.New("cannot have blank name for res target in update")
 		}
-		out = append(out, *node.Name)
+		if str, err := node.deparseName(); err != nil {
+			return nil, err
+		} else {
+			out = append(out, *str)
+		}
 
 		if node.Val == nil {
 			return nil, errors.New("cannot have null value for res target in update")
@@ -48,3 +52,23 @@ func (node ResTarget) Deparse(ctx Context) (*string, error) {
 		return nil, errors.Errorf("context type %s is not currently implemented", ctx.String())
 	}
 }
+
+// deparseName returns the name of the target column including any subscripts
+// or fields of it that are being assigned to.
+func (node ResTarget) deparseName() (*string, error) {
+	if node.Name == nil {
+		return nil, errors.New("res target must have a name")
+	}
+
+	name := quoteIdentifier(*node.Name)
+	if node.Indirection.Items == nil || len(node.Indirection.Items) == 0 {
+		return &name, nil
+	}
+
+	if indirection, err := deparseIndirection(node.Indirection); err != nil {
+		return nil, err
+	} else {
+		result := name + *indirection
+		return &result, nil
+	}
+}
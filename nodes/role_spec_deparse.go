@@ -2,6 +2,26 @@
 
 package pg_query
 
+import (
+	"github.com/juju/errors"
+)
+
 func (node RoleSpec) Deparse(ctx Context) (*string, error) {
-	panic("Not Implemented")
+	result := ""
+	switch node.Roletype {
+	case ROLESPEC_CSTRING:
+		if node.Rolename == nil {
+			return nil, errors.New("role name cannot be null")
+		}
+		result = quoteIdentifier(*node.Rolename)
+	case ROLESPEC_CURRENT_USER:
+		result = "CURRENT_USER"
+	case ROLESPEC_SESSION_USER:
+		result = "SESSION_USER"
+	case ROLESPEC_PUBLIC:
+		result = "PUBLIC"
+	default:
+		return nil, errors.Errorf("cannot deparse role spec type (%d)", node.Roletype)
+	}
+	return &result, nil
 }
@@ -2,6 +2,24 @@
 
 package pg_query
 
+import (
+	"fmt"
+	"strings"
+)
+
 func (node RowExpr) Deparse(ctx Context) (*string, error) {
-	panic("Not Implemented")
+	args, err := node.Args.DeparseList(Context_None)
+	if err != nil {
+		return nil, err
+	}
+
+	// Rows written without the ROW keyword are flagged as implicit, they
+	// always have more than one field so they cannot be mistaken for a
+	// parenthesized expression.
+	result := fmt.Sprintf("(%s)", strings.Join(args, ", "))
+	if node.RowFormat != COERCE_IMPLICIT_CAST {
+		result = fmt.Sprintf("ROW%s", result)
+	}
+
+	return &result, nil
 }
@@ -2,6 +2,76 @@
 
 package pg_query
 
+import (
+	"fmt"
+	"strings"
+
+	"github.com/juju/errors"
+)
+
+var (
+	ruleEvents = map[CmdType]string{
+		CMD_SELECT: "SELECT",
+		CMD_UPDATE: "UPDATE",
+		CMD_INSERT: "INSERT",
+		CMD_DELETE: "DELETE",
+	}
+)
+
 func (node RuleStmt) Deparse(ctx Context) (*string, error) {
-	panic("Not Implemented")
+	out := []string{"CREATE"}
+	if node.Replace {
+		out = append(out, "OR REPLACE")
+	}
+
+	if node.Rulename == nil {
+		return nil, errors.New("rule must have a name")
+	}
+	out = append(out, "RULE", quoteIdentifier(*node.Rulename), "AS ON")
+
+	if event, ok := ruleEvents[node.Event]; !ok {
+		return nil, errors.Errorf("cannot deparse rule event (%d)", node.Event)
+	} else {
+		out = append(out, event)
+	}
+
+	if node.Relation == nil {
+		return nil, errors.New("rule must have a relation")
+	}
+
+	if str, err := node.Relation.Deparse(Context_None); err != nil {
+		return nil, err
+	} else {
+		out = append(out, "TO", *str)
+	}
+
+	if node.WhereClause != nil {
+		if str, err := deparseNode(node.WhereClause, Context_None); err != nil {
+			return nil, err
+		} else {
+			out = append(out, "WHERE", *str)
+		}
+	}
+
+	out = append(out, "DO")
+	if node.Instead {
+		out = append(out, "INSTEAD")
+	}
+
+	actions, err := node.Actions.DeparseList(Context_None)
+	if err != nil {
+		return nil, err
+	}
+
+	switch len(actions) {
+	case 0:
+		out = append(out, "NOTHING")
+	case 1:
+		out = append(out, actions[0])
+	default:
+		out = append(out, fmt.Sprintf("(%s)", strings.Join(actions, "; ")))
+	}
+
+	result := strings.Join(out, " ")
+	return &result, nil
 }
@@ -2,6 +2,36 @@
 
 package pg_query
 
+import (
+	"strings"
+)
+
 func (node SecLabelStmt) Deparse(ctx Context) (*string, error) {
-	panic("Not Implemented")
+	out := []string{"SECURITY LABEL"}
+	if node.Provider != nil {
+		out = append(out, "FOR", quoteIdentifier(*node.Provider))
+	}
+
+	out = append(out, "ON")
+	if str, err := deparseObjectType(node.Objtype); err != nil {
+		return nil, err
+	} else {
+		out = append(out, *str)
+	}
+
+	if str, err := deparseObjectName(node.Objtype, nil, node.Object); err != nil {
+		return nil, err
+	} else {
+		out = append(out, *str)
+	}
+
+	// A missing label removes the existing label.
+	if node.Label == nil {
+		out = append(out, "IS NULL")
+	} else {
+		out = append(out, "IS", quoteLiteral(*node.Label))
+	}
+
+	result := strings.Join(out, " ")
+	return &result, nil
 }
@@ -5,46 +5,49 @@ package pg_query
 import (
 	"fmt"
 	"strings"
+
+	"github.com/juju/errors"
+)
+
+var (
+	setOperations = map[SetOperation]string{
+		SETOP_UNION:     "UNION",
+		SETOP_INTERSECT: "INTERSECT",
+		SETOP_EXCEPT:    "EXCEPT",
+	}
 )
 
 func (node SelectStmt) Deparse(ctx Context) (*string, error) {
 	out := make([]string, 0)
-	if node.Op == SETOP_UNION {
-		if str, err := deparseNode(node.Larg, Context_None); err != nil {
-			return nil, err
-		} else {
-			out = append(out, *str)
-		}
-
-		out = append(out, "UNION")
-		if node.All {
-			out = append(out, "ALL")
-		}
-
-		if str, err := deparseNode(node.Rarg, Context_None); err != nil {
+	if node.WithClause != nil {
+		if str, err := deparseNode(node.WithClause, Context_None); err != nil {
 			return nil, err
 		} else {
 			out = append(out, *str)
 		}
-
-		result := strings.Join(out, " ")
-		return &result, nil
 	}
 
-	if node.WithClause != nil {
-		if str, err := deparseNode(node.WithClause, Context_None); err != nil {
+	// The ORDER BY, LIMIT and locking clauses below apply to the result of
+	// the whole set operation.
+	if node.Op != SETOP_NONE {
+		if str, err := node.deparseSetOperation(); err != nil {
 			return nil, err
 		} else {
 			out = append(out, *str)
 		}
 	}
 
-	// Get select *distinct* *fields*
-	if node.TargetList.Items != nil && len(node.TargetList.Items) > 0 {
+	// Get select *distinct* *fields*. A plain select is allowed to have an
+	// empty target list, so SELECT is emitted for anything that is neither a
+	// set operation nor a VALUES list.
+	if node.Op == SETOP_NONE && len(node.ValuesLists) == 0 {
 		out = append(out, "SELECT")
 		if node.DistinctClause.Items != nil && len(node.DistinctClause.Items) > 0 {
 			out = append(out, "DISTINCT")
 		}
+	}
+
+	if node.TargetList.Items != nil && len(node.TargetList.Items) > 0 {
 		fields := make([]string, len(node.TargetList.Items))
 		for i, field := range node.TargetList.Items {
 			if str, err := deparseNode(field, Context_Select); err != nil {
@@ -56,6 +59,23 @@ func (node SelectStmt) Deparse(ctx Context) (*string, error) {
 		out = append(out, strings.Join(fields, ", "))
 	}
 
+	if node.IntoClause != nil {
+		if node.IntoClause.Rel == nil {
+			return nil, errors.New("select into must have a target relation")
+		}
+
+		out = append(out, "INTO")
+		if persistence := node.IntoClause.Rel.relPersistence(); persistence != nil {
+			out = append(out, *persistence)
+		}
+
+		if str, err := node.IntoClause.Deparse(Context_None); err != nil {
+			return nil, err
+		} else {
+			out = append(out, *str)
+		}
+	}
+
 	if node.FromClause.Items != nil && len(node.FromClause.Items) > 0 {
 		out = append(out, "FROM")
 		froms := make([]string, len(node.FromClause.Items))
@@ -109,6 +129,7 @@ func (node SelectStmt) Deparse(ctx Context) (*string, error) {
 	}
 
 	if node.HavingClause != nil {
+		out = append(out, "HAVING")
 		if str, err := deparseNode(node.HavingClause, Context_None); err != nil {
 			return nil, err
 		} else {
@@ -116,6 +137,24 @@ func (node SelectStmt) Deparse(ctx Context) (*string, error) {
 		}
 	}
 
+	if node.WindowClause.Items != nil && len(node.WindowClause.Items) > 0 {
+		out = append(out, "WINDOW")
+		windows := make([]string, len(node.WindowClause.Items))
+		for i, item := range node.WindowClause.Items {
+			window, ok := item.(WindowDef)
+			if !ok || window.Name == nil {
+				return nil, errors.New("window clause must only contain named window definitions")
+			}
+
+			if str, err := window.Deparse(Context_None); err != nil {
+				return nil, err
+			} else {
+				windows[i] = fmt.Sprintf("%s AS (%s)", quoteIdentifier(*window.Name), *str)
+			}
+		}
+		out = append(out, strings.Join(windows, ", "))
+	}
+
 	// Sort clause
 	if len(node.SortClause.Items) > 0 {
 		out = append(out, "ORDER BY")
@@ -162,3 +201,63 @@ func (node SelectStmt) Deparse(ctx Context) (*string, error) {
 	result := strings.Join(out, " ")
 	return &result, nil
 }
+
+func (node SelectStmt) deparseSetOperation() (*string, error) {
+	op, ok := setOperations[node.Op]
+	if !ok {
+		return nil, errors.Errorf("cannot deparse set operation (%d)", node.Op)
+	}
+
+	if node.Larg == nil || node.Rarg == nil {
+		return nil, errors.New("set operation must have two operands")
+	}
+
+	out := make([]string, 0)
+	if str, err := node.Larg.deparseSetOperand(node.Op, false); err != nil {
+		return nil, err
+	} else {
+		out = append(out, *str)
+	}
+
+	out = append(out, op)
+	if node.All {
+		out = append(out, "ALL")
+	}
+
+	if str, err := node.Rarg.deparseSetOperand(node.Op, true); err != nil {
+		return nil, err
+	} else {
+		out = append(out, *str)
+	}
+
+	result := strings.Join(out, " ")
+	return &result, nil
+}
+
+// deparseSetOperand wraps an operand of a set operation in parentheses when
+// its own clauses would otherwise apply to the whole set operation, or when
+// the parser would group it differently. INTERSECT binds tighter than UNION
+// and EXCEPT, and all of them are left-associative.
+func (node SelectStmt) deparseSetOperand(parent SetOperation, right bool) (*string, error) {
+	str, err := node.Deparse(Context_None)
+	if err != nil {
+		return nil, err
+	}
+
+	parens := false
+	if node.WithClause != nil || node.LimitCount != nil || node.LimitOffset != nil ||
+		len(node.SortClause.Items) > 0 || len(node.LockingClause.Items) > 0 {
+		parens = true
+	} else if node.Op == SETOP_INTERSECT {
+		parens = right && parent == SETOP_INTERSECT
+	} else if node.Op != SETOP_NONE {
+		parens = right || parent == SETOP_INTERSECT
+	}
+
+	if parens {
+		result := fmt.Sprintf("(%s)", *str)
+		return &result, nil
+	}
+
+	return str, nil
+}
@@ -41,7 +41,7 @@ from pg_catalog.pg_database N
   left join pg_catalog.pg_shdescription D on N.oid = D.objoid
 where not datistemplate
 order by case when datname = pg_catalog.current_database() then -1::bigint else N.oid::bigint end`,
-		Expected: `SELECT "n"."oid"::bigint AS id, "datname" AS name, "d"."description" FROM "pg_catalog"."pg_database" n LEFT JOIN "pg_catalog"."pg_shdescription" d ON "n"."oid" = "d"."objoid" WHERE NOT datistemplate ORDER BY CASE WHEN "datname" = current_database() THEN 1::bigint ELSE "n"."oid"::bigint END`,
+		Expected: `SELECT "n"."oid"::bigint AS id, "datname" AS name, "d"."description" FROM "pg_catalog"."pg_database" n LEFT JOIN "pg_catalog"."pg_shdescription" d ON "n"."oid" = "d"."objoid" WHERE NOT datistemplate ORDER BY CASE WHEN "datname" = current_database() THEN - 1::bigint ELSE "n"."oid"::bigint END`,
 	})
 }
 
@@ -72,7 +72,7 @@ func Test_SelectStmt_CurrentTimestamp(t *testing.T) {
 func Test_SelectStmt_FunctionCall(t *testing.T) {
 	DoTest(t, DeparseTest{
 		Query:    `select current_database() as a, current_schemas(false) as b, totalRecords() as c`,
-		Expected: `SELECT pg_catalog.current_database() AS a, pg_catalog.current_schemas(false) AS b, pg_catalog.totalrecords() AS c`,
+		Expected: `SELECT current_database() AS a, current_schemas(false) AS b, totalrecords() AS c`,
 	})
 }
 
@@ -93,6 +93,87 @@ func Test_SelectStmt_NullIf(t *testing.T) {
 func Test_SelectStmt_Weird_Params(t *testing.T) {
 	DoTest(t, DeparseTest{
 		Query:    `SELECT n.nspname = ANY(current_schemas(true)), n.nspname, t.typname FROM pg_catalog.pg_type t JOIN pg_catalog.pg_namespace n ON t.typnamespace = n.oid WHERE t.oid = $1`,
-		Expected: `SELECT "n"."nspname"=ANY(pg_catalog.current_schemas(true)), "n"."nspname", "t"."typname" FROM "pg_catalog"."pg_type" t JOIN "pg_catalog"."pg_namespace" n ON "t"."typnamespace" = "n"."oid" WHERE "t"."oid" = $1`,
+		Expected: `SELECT "n"."nspname"=ANY(current_schemas(true)), "n"."nspname", "t"."typname" FROM "pg_catalog"."pg_type" t JOIN "pg_catalog"."pg_namespace" n ON "t"."typnamespace" = "n"."oid" WHERE "t"."oid" = $1`,
+	})
+}
+
+func Test_SelectStmt_Union(t *testing.T) {
+	DoTest(t, DeparseTest{
+		Query:    `SELECT 1 AS two UNION SELECT 2 UNION SELECT 2 ORDER BY 1;`,
+		Expected: `SELECT 1 AS two UNION SELECT 2 UNION SELECT 2 ORDER BY 1`,
+	})
+	DoTest(t, DeparseTest{
+		Query:    `SELECT q1 FROM int8_tbl UNION ALL SELECT q2 FROM int8_tbl LIMIT 5 OFFSET 2;`,
+		Expected: `SELECT "q1" FROM "int8_tbl" UNION ALL SELECT "q2" FROM "int8_tbl" LIMIT 5 OFFSET 2`,
+	})
+}
+
+func Test_SelectStmt_IntersectExcept(t *testing.T) {
+	DoTest(t, DeparseTest{
+		Query:    `SELECT q2 FROM int8_tbl INTERSECT ALL SELECT q1 FROM int8_tbl ORDER BY 1;`,
+		Expected: `SELECT "q2" FROM "int8_tbl" INTERSECT ALL SELECT "q1" FROM "int8_tbl" ORDER BY 1`,
+	})
+	DoTest(t, DeparseTest{
+		Query:    `SELECT q2 FROM int8_tbl EXCEPT ALL SELECT DISTINCT q1 FROM int8_tbl ORDER BY 1;`,
+		Expected: `SELECT "q2" FROM "int8_tbl" EXCEPT ALL SELECT DISTINCT "q1" FROM "int8_tbl" ORDER BY 1`,
+	})
+}
+
+func Test_SelectStmt_EmptyTargetList(t *testing.T) {
+	DoTest(t, DeparseTest{
+		Query:    `select from generate_series(1,5) intersect select from generate_series(1,3);`,
+		Expected: `SELECT FROM generate_series(1, 5) INTERSECT SELECT FROM generate_series(1, 3)`,
+	})
+}
+
+func Test_SelectStmt_SetOperationPrecedence(t *testing.T) {
+	DoTest(t, DeparseTest{
+		Query:    `SELECT q1 FROM int8_tbl INTERSECT SELECT q2 FROM int8_tbl UNION ALL SELECT q2 FROM int8_tbl ORDER BY 1;`,
+		Expected: `SELECT "q1" FROM "int8_tbl" INTERSECT SELECT "q2" FROM "int8_tbl" UNION ALL SELECT "q2" FROM "int8_tbl" ORDER BY 1`,
+	})
+	DoTest(t, DeparseTest{
+		Query:    `SELECT q1 FROM int8_tbl INTERSECT (((SELECT q2 FROM int8_tbl UNION ALL SELECT q2 FROM int8_tbl))) ORDER BY 1;`,
+		Expected: `SELECT "q1" FROM "int8_tbl" INTERSECT (SELECT "q2" FROM "int8_tbl" UNION ALL SELECT "q2" FROM "int8_tbl") ORDER BY 1`,
+	})
+	DoTest(t, DeparseTest{
+		Query:    `SELECT q1 FROM int8_tbl UNION ALL SELECT q2 FROM int8_tbl EXCEPT SELECT q1 FROM int8_tbl ORDER BY 1;`,
+		Expected: `SELECT "q1" FROM "int8_tbl" UNION ALL SELECT "q2" FROM "int8_tbl" EXCEPT SELECT "q1" FROM "int8_tbl" ORDER BY 1`,
+	})
+	DoTest(t, DeparseTest{
+		Query:    `(SELECT 1,2,3 UNION SELECT 4,5,6) INTERSECT SELECT 4,5,6;`,
+		Expected: `(SELECT 1, 2, 3 UNION SELECT 4, 5, 6) INTERSECT SELECT 4, 5, 6`,
+	})
+	DoTest(t, DeparseTest{
+		Query:    `SELECT 1 UNION (SELECT 2 UNION SELECT 3);`,
+		Expected: `SELECT 1 UNION (SELECT 2 UNION SELECT 3)`,
+	})
+}
+
+func Test_SelectStmt_SetOperationOperandClauses(t *testing.T) {
+	DoTest(t, DeparseTest{
+		Query:    `(SELECT 1,2,3 UNION SELECT 4,5,6 ORDER BY 1,2) EXCEPT SELECT 4,5,6;`,
+		Expected: `(SELECT 1, 2, 3 UNION SELECT 4, 5, 6 ORDER BY 1, 2) EXCEPT SELECT 4, 5, 6`,
+	})
+	DoTest(t, DeparseTest{
+		Query:    `SELECT q1 FROM int8_tbl EXCEPT (((SELECT q2 FROM int8_tbl ORDER BY q2 LIMIT 1))) ORDER BY 1;`,
+		Expected: `SELECT "q1" FROM "int8_tbl" EXCEPT (SELECT "q2" FROM "int8_tbl" ORDER BY "q2" LIMIT 1) ORDER BY 1`,
+	})
+}
+
+func Test_SelectStmt_Having(t *testing.T) {
+	DoTest(t, DeparseTest{
+		Query:    `SELECT four, count(*) FROM tenk1 GROUP BY four HAVING count(*) > 1;`,
+		Expected: `SELECT "four", count(*) FROM "tenk1" GROUP BY "four" HAVING count(*) > 1`,
+	})
+}
+
+func Test_SelectStmt_Into(t *testing.T) {
+	DoTest(t, DeparseTest{
+		Query:    `SELECT 1 INTO TEMP t3;`,
+		Expected: `SELECT 1 INTO TEMPORARY "t3"`,
+	})
+	DoTest(t, DeparseTest{
+		Query:    `SELECT a, b INTO UNLOGGED TABLE s.t FROM u WHERE a > 1;`,
+		Expected: `SELECT "a", "b" INTO UNLOGGED "s"."t" FROM "u" WHERE "a" > 1`,
 	})
 }
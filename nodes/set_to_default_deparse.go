@@ -3,5 +3,6 @@
 package pg_query
 
 func (node SetToDefault) Deparse(ctx Context) (*string, error) {
-	panic("Not Implemented")
+	result := "DEFAULT"
+	return &result, nil
 }
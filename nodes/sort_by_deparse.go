@@ -33,6 +33,12 @@ func (node SortBy) Deparse(ctx Context) (*string, error) {
 		}
 	}
 
+	if nulls, ok := sortNullsOrdering[node.SortbyNulls]; !ok {
+		return nil, errors.New(fmt.Sprintf("cannot handle nulls ordering (%d)", node.SortbyNulls))
+	} else if nulls != "" {
+		out = append(out, nulls)
+	}
+
 	result := strings.Join(out, " ")
 	return &result, nil
 }
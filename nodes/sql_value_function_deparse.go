@@ -4,18 +4,40 @@ package pg_query
 
 import (
 	"fmt"
+
+	"github.com/juju/errors"
+)
+
+var (
+	sqlValueFunctions = map[SQLValueFunctionOp]string{
+		SVFOP_CURRENT_DATE:        "CURRENT_DATE",
+		SVFOP_CURRENT_TIME:        "CURRENT_TIME",
+		SVFOP_CURRENT_TIME_N:      "CURRENT_TIME",
+		SVFOP_CURRENT_TIMESTAMP:   "CURRENT_TIMESTAMP",
+		SVFOP_CURRENT_TIMESTAMP_N: "CURRENT_TIMESTAMP",
+		SVFOP_LOCALTIME:           "LOCALTIME",
+		SVFOP_LOCALTIME_N:         "LOCALTIME",
+		SVFOP_LOCALTIMESTAMP:      "LOCALTIMESTAMP",
+		SVFOP_LOCALTIMESTAMP_N:    "LOCALTIMESTAMP",
+		SVFOP_CURRENT_ROLE:        "CURRENT_ROLE",
+		SVFOP_CURRENT_USER:        "CURRENT_USER",
+		SVFOP_USER:                "USER",
+		SVFOP_SESSION_USER:        "SESSION_USER",
+		SVFOP_CURRENT_CATALOG:     "CURRENT_CATALOG",
+		SVFOP_CURRENT_SCHEMA:      "CURRENT_SCHEMA",
+	}
 )
 
 func (node SQLValueFunction) Deparse(ctx Context) (*string, error) {
+	result, ok := sqlValueFunctions[node.Op]
+	if !ok {
+		return nil, errors.Errorf("cannot deparse sql value function (%d)", node.Op)
+	}
+
+	// The variants that take a precision keep it as the type modifier.
 	switch node.Op {
-	case SVFOP_CURRENT_TIMESTAMP:
-		result := "CURRENT_TIMESTAMP"
-		return &result, nil
-	case SVFOP_CURRENT_USER:
-		result := "CURRENT_USER"
-		return &result, nil
-	default:
-		panic(fmt.Sprintf("cannot deparse SQLValueFunction %d at this time", node.Op))
+	case SVFOP_CURRENT_TIME_N, SVFOP_CURRENT_TIMESTAMP_N, SVFOP_LOCALTIME_N, SVFOP_LOCALTIMESTAMP_N:
+		result = fmt.Sprintf("%s(%d)", result, node.Typmod)
 	}
-	return nil, nil
+	return &result, nil
 }
@@ -10,9 +10,12 @@ import (
 func (node String) Deparse(ctx Context) (*string, error) {
 	switch ctx {
 	case Context_AConst:
-		result := fmt.Sprintf("'%s'", strings.Replace(node.Str, "'", "''", -1))
+		result := quoteLiteral(node.Str)
 		return &result, nil
-	case Context_FuncCall, Context_TypeName, Context_Operator:
+	case Context_FuncCall:
+		result := quoteIdentifier(node.Str)
+		return &result, nil
+	case Context_TypeName, Context_Operator:
 		return &node.Str, nil
 	default:
 		result := fmt.Sprintf(`"%s"`, strings.Replace(node.Str, `"`, `""`, -1))
@@ -5,35 +5,63 @@ package pg_query
 import (
 	"fmt"
 	"strings"
+
+	"github.com/juju/errors"
 )
 
 func (node SubLink) Deparse(ctx Context) (*string, error) {
+	subSelect, err := deparseNode(node.Subselect, Context_None)
+	if err != nil {
+		return nil, err
+	}
+
 	switch node.SubLinkType {
-	case EXPR_SUBLINK:
-		if subSelect, err := node.Subselect.Deparse(Context_None); err != nil {
-			return nil, err
-		} else {
-			result := fmt.Sprintf("(%s)", *subSelect)
-			return &result, err
+	case EXPR_SUBLINK, MULTIEXPR_SUBLINK:
+		result := fmt.Sprintf("(%s)", *subSelect)
+		return &result, nil
+	case EXISTS_SUBLINK:
+		result := fmt.Sprintf("EXISTS (%s)", *subSelect)
+		return &result, nil
+	case ARRAY_SUBLINK:
+		result := fmt.Sprintf("ARRAY(%s)", *subSelect)
+		return &result, nil
+	case ANY_SUBLINK, ALL_SUBLINK, ROWCOMPARE_SUBLINK:
+		out := make([]string, 0)
+		if node.Testexpr == nil {
+			return nil, errors.Errorf("sub link type (%d) must have a test expression", node.SubLinkType)
 		}
-	case ANY_SUBLINK:
-		out := []string{"", "IN", ""}
-		if columnRef, err := node.Testexpr.Deparse(Context_None); err != nil {
+
+		if str, err := deparseNode(node.Testexpr, Context_None); err != nil {
 			return nil, err
 		} else {
-			out[0] = *columnRef
+			out = append(out, *str)
+		}
+
+		// IN is represented as an ANY sub link without an operator.
+		if node.SubLinkType == ANY_SUBLINK && len(node.OperName.Items) == 0 {
+			out = append(out, "IN", fmt.Sprintf("(%s)", *subSelect))
+			result := strings.Join(out, " ")
+			return &result, nil
 		}
 
-		if subSelect, err := node.Subselect.Deparse(Context_None); err != nil {
+		operator, err := deparseOperatorName(node.OperName)
+		if err != nil {
 			return nil, err
-		} else {
-			out[2] = fmt.Sprintf("(%s)", *subSelect)
+		}
+		out = append(out, *operator)
+
+		switch node.SubLinkType {
+		case ANY_SUBLINK:
+			out = append(out, fmt.Sprintf("ANY (%s)", *subSelect))
+		case ALL_SUBLINK:
+			out = append(out, fmt.Sprintf("ALL (%s)", *subSelect))
+		default:
+			out = append(out, fmt.Sprintf("(%s)", *subSelect))
 		}
 
 		result := strings.Join(out, " ")
 		return &result, nil
 	default:
-		panic(fmt.Sprintf("cannot handle sub link type [%s]", node.SubLinkType.String()))
+		return nil, errors.Errorf("cannot deparse sub link type (%d)", node.SubLinkType)
 	}
-	return nil, nil
 }
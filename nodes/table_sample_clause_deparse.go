@@ -3,5 +3,5 @@
 package pg_query
 
 func (node TableSampleClause) Deparse(ctx Context) (*string, error) {
-	panic("Not Implemented")
+	return nil, newUnsupportedNodeError(node)
 }
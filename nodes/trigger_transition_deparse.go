@@ -2,6 +2,32 @@
 
 package pg_query
 
+import (
+	"strings"
+
+	"github.com/juju/errors"
+)
+
 func (node TriggerTransition) Deparse(ctx Context) (*string, error) {
-	panic("Not Implemented")
+	if node.Name == nil {
+		return nil, errors.New("trigger transition must have a name")
+	}
+
+	out := make([]string, 0)
+	if node.IsNew {
+		out = append(out, "NEW")
+	} else {
+		out = append(out, "OLD")
+	}
+
+	if node.IsTable {
+		out = append(out, "TABLE")
+	} else {
+		out = append(out, "ROW")
+	}
+
+	out = append(out, "AS", quoteIdentifier(*node.Name))
+
+	result := strings.Join(out, " ")
+	return &result, nil
 }
@@ -0,0 +1,31 @@
+/*
+ * Copyright (c) 2019 Ready Stock
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+package pg_query
+
+/* Bits within tgtype */
+const (
+	TRIGGER_TYPE_ROW      = 1 << 0
+	TRIGGER_TYPE_BEFORE   = 1 << 1
+	TRIGGER_TYPE_INSERT   = 1 << 2
+	TRIGGER_TYPE_DELETE   = 1 << 3
+	TRIGGER_TYPE_UPDATE   = 1 << 4
+	TRIGGER_TYPE_TRUNCATE = 1 << 5
+	TRIGGER_TYPE_INSTEAD  = 1 << 6
+
+	TRIGGER_TYPE_STATEMENT = 0
+	TRIGGER_TYPE_AFTER     = 0
+)
@@ -2,6 +2,26 @@
 
 package pg_query
 
+import (
+	"strings"
+)
+
 func (node TruncateStmt) Deparse(ctx Context) (*string, error) {
-	panic("Not Implemented")
+	out := []string{"TRUNCATE"}
+	if relations, err := node.Relations.DeparseList(Context_None); err != nil {
+		return nil, err
+	} else {
+		out = append(out, strings.Join(relations, ", "))
+	}
+
+	if node.RestartSeqs {
+		out = append(out, "RESTART IDENTITY")
+	}
+
+	if node.Behavior == DROP_CASCADE {
+		out = append(out, "CASCADE")
+	}
+
+	result := strings.Join(out, " ")
+	return &result, nil
 }
@@ -5,10 +5,58 @@ package pg_query
 import (
 	"fmt"
 	"github.com/juju/errors"
-	"reflect"
 	"strings"
 )
 
+const (
+	intervalMaskMonth  = 1 << 1
+	intervalMaskYear   = 1 << 2
+	intervalMaskDay    = 1 << 3
+	intervalMaskHour   = 1 << 10
+	intervalMaskMinute = 1 << 11
+	intervalMaskSecond = 1 << 12
+
+	intervalFullRange = 0x7FFF
+)
+
+var (
+	// systemTypeNames are the SQL names of the built-in types that the parser
+	// qualifies with pg_catalog, which are the ones with special syntax.
+	systemTypeNames = map[string]string{
+		"bpchar":      "char",
+		"varchar":     "varchar",
+		"numeric":     "numeric",
+		"bool":        "boolean",
+		"int2":        "smallint",
+		"int4":        "int",
+		"int8":        "bigint",
+		"float4":      "real",
+		"float8":      "double precision",
+		"bit":         "bit",
+		"varbit":      "bit varying",
+		"time":        "time",
+		"timetz":      "time",
+		"timestamp":   "timestamp",
+		"timestamptz": "timestamp",
+	}
+
+	intervalFields = map[int64]string{
+		intervalMaskYear:                     "year",
+		intervalMaskMonth:                    "month",
+		intervalMaskDay:                      "day",
+		intervalMaskHour:                     "hour",
+		intervalMaskMinute:                   "minute",
+		intervalMaskSecond:                   "second",
+		intervalMaskYear | intervalMaskMonth: "year to month",
+		intervalMaskDay | intervalMaskHour:   "day to hour",
+		intervalMaskDay | intervalMaskHour | intervalMaskMinute:                      "day to minute",
+		intervalMaskDay | intervalMaskHour | intervalMaskMinute | intervalMaskSecond: "day to second",
+		intervalMaskHour | intervalMaskMinute:                                        "hour to minute",
+		intervalMaskHour | intervalMaskMinute | intervalMaskSecond:                   "hour to second",
+		intervalMaskMinute | intervalMaskSecond:                                      "minute to second",
+	}
+)
+
 func (node TypeName) Deparse(ctx Context) (*string, error) {
 	if node.Names.Items == nil || len(node.Names.Items) == 0 {
 		return nil, errors.New("cannot have no names on type name")
@@ -22,120 +70,116 @@ func (node TypeName) Deparse(ctx Context) (*string, error) {
 		}
 	}
 
-	// Intervals are tricky and should be handled in a seperate method because they require some bitmask operations
-	if reflect.DeepEqual(names, []string{"pg_catalog", "interval"}) {
-		return node.deparseIntervalType()
-	}
-
 	out := make([]string, 0)
 	if node.Setof {
 		out = append(out, "SETOF")
 	}
 
-	args := ""
-	if node.Typmods.Items != nil && len(node.Typmods.Items) > 0 {
-		arguments := make([]string, len(node.Typmods.Items))
-		for i, arg := range node.Typmods.Items {
-			if str, err := deparseNode(arg, Context_None); err != nil {
+	if len(names) == 2 && names[0] == "pg_catalog" && names[1] == "interval" {
+		// Intervals are tricky and should be handled in a seperate method because they require some bitmask operations
+		if str, err := node.deparseIntervalType(); err != nil {
+			return nil, err
+		} else {
+			out = append(out, *str)
+		}
+	} else {
+		args := ""
+		if node.Typmods.Items != nil && len(node.Typmods.Items) > 0 {
+			if arguments, err := node.Typmods.DeparseList(Context_None); err != nil {
 				return nil, err
 			} else {
-				arguments[i] = *str
+				args = strings.Join(arguments, ", ")
 			}
 		}
-		args = strings.Join(arguments, ", ")
+
+		out = append(out, node.deparseTypeNameCase(names, args))
 	}
 
-	if str, err := node.deparseTypeNameCase(names, args); err != nil {
-		return nil, err
-	} else {
-		out = append(out, *str)
+	if node.PctType {
+		out[len(out)-1] = fmt.Sprintf("%s%%TYPE", out[len(out)-1])
 	}
 
-	if node.ArrayBounds.Items != nil || len(node.ArrayBounds.Items) > 0 {
-		out[len(out)-1] = fmt.Sprintf("%s[]", out[len(out)-1])
+	for _, bound := range node.ArrayBounds.Items {
+		if value, ok := bound.(Integer); ok && value.Ival >= 0 {
+			out[len(out)-1] = fmt.Sprintf("%s[%d]", out[len(out)-1], value.Ival)
+		} else {
+			out[len(out)-1] = fmt.Sprintf("%s[]", out[len(out)-1])
+		}
 	}
 
-	result := strings.Join(out, ", ")
+	result := strings.Join(out, " ")
 	return &result, nil
 }
 
+// deparseIntervalType handles the field restriction of an interval, which is
+// stored as a bitmask in the first type modifier, followed by the precision of
+// the seconds if one was given.
 func (node TypeName) deparseIntervalType() (*string, error) {
 	out := []string{"interval"}
 
-	if node.Typmods.Items != nil && len(node.Typmods.Items) > 0 {
-		return nil, nil
-		// In the ruby version of this code this was here to
-		// handle `interval hour to second(5)` but i've not
-		// ever seen that syntax and will come back to it
+	typmods := make([]int64, len(node.Typmods.Items))
+	for i, item := range node.Typmods.Items {
+		constant, ok := item.(A_Const)
+		if !ok {
+			return nil, errors.New("interval type modifiers must be constants")
+		}
+
+		value, ok := constant.Val.(Integer)
+		if !ok {
+			return nil, errors.New("interval type modifiers must be integers")
+		}
+		typmods[i] = value.Ival
+	}
+
+	precision := ""
+	if len(typmods) > 1 {
+		precision = fmt.Sprintf("(%d)", typmods[1])
+	}
+
+	if len(typmods) > 0 && typmods[0] != intervalFullRange {
+		fields, ok := intervalFields[typmods[0]]
+		if !ok {
+			return nil, errors.Errorf("cannot deparse interval fields (%d)", typmods[0])
+		}
+		out = append(out, fmt.Sprintf("%s%s", fields, precision))
+	} else {
+		out[0] = fmt.Sprintf("%s%s", out[0], precision)
 	}
 
 	result := strings.Join(out, " ")
 	return &result, nil
 }
 
-func (node TypeName) deparseTypeNameCase(names []string, arguments string) (*string, error) {
-	if names[0] != "pg_catalog" {
-		result := strings.Join(names, ".")
-		return &result, nil
+func (node TypeName) deparseTypeNameCase(names []string, arguments string) string {
+	if arguments != "" {
+		arguments = fmt.Sprintf("(%s)", arguments)
 	}
 
-	switch names[len(names)-1] {
-	case "bpchar":
-		if len(arguments) == 0 {
-			result := "char"
-			return &result, nil
-		} else {
-			result := fmt.Sprintf("char(%s)", arguments)
-			return &result, nil
-		}
-	case "varchar":
-		if len(arguments) == 0 {
-			result := "varchar"
-			return &result, nil
-		} else {
-			result := fmt.Sprintf("varchar(%s)", arguments)
-			return &result, nil
+	if len(names) != 2 || names[0] != "pg_catalog" {
+		quoted := make([]string, len(names))
+		for i, name := range names {
+			quoted[i] = quoteIdentifier(name)
 		}
-	case "numeric":
-		if len(arguments) == 0 {
-			result := "numeric"
-			return &result, nil
-		} else {
-			result := fmt.Sprintf("numeric(%s)", arguments)
-			return &result, nil
+		return fmt.Sprintf("%s%s", strings.Join(quoted, "."), arguments)
+	}
+
+	name, ok := systemTypeNames[names[1]]
+	if !ok {
+		// Any other type was explicitly qualified with pg_catalog.
+		return fmt.Sprintf("pg_catalog.%s%s", quoteIdentifier(names[1]), arguments)
+	}
+
+	switch names[1] {
+	case "bpchar":
+		// A plain char is stored with a length of one, without any length the
+		// type has to be written as bpchar to keep the length unlimited.
+		if arguments == "" {
+			return "bpchar"
 		}
-	case "bool":
-		result := "boolean"
-		return &result, nil
-	case "int2":
-		result := "smallint"
-		return &result, nil
-	case "int4":
-		result := "int"
-		return &result, nil
-	case "int8":
-		result := "bigint"
-		return &result, nil
-	case "real", "float4":
-		result := "real"
-		return &result, nil
-	case "float8":
-		result := "double"
-		return &result, nil
-	case "time":
-		result := "time"
-		return &result, nil
-	case "timezt":
-		result := "time with time zone"
-		return &result, nil
-	case "timestamp":
-		result := "timestamp"
-		return &result, nil
-	case "timestamptz":
-		result := "timestamp with time zone"
-		return &result, nil
+		return fmt.Sprintf("%s%s", name, arguments)
+	case "timetz", "timestamptz":
+		return fmt.Sprintf("%s%s with time zone", name, arguments)
 	default:
-		return nil, errors.Errorf("cannot deparse type: %s", names[len(names)-1])
+		return fmt.Sprintf("%s%s", name, arguments)
 	}
-	return nil, nil
 }
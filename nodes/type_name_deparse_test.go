@@ -0,0 +1,75 @@
+/*
+ * Copyright (c) 2019 Ready Stock
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+package pg_query
+
+import (
+	"testing"
+)
+
+func Test_TypeName_SystemTypes(t *testing.T) {
+	DoTest(t, DeparseTest{
+		Query:    `CREATE TABLE t (a smallint, b integer, c bigint, d real, e double precision, f boolean, g numeric(10, 2), h decimal);`,
+		Expected: `CREATE TABLE "t" (a smallint, b int, c bigint, d real, e double precision, f boolean, g numeric(10, 2), h numeric)`,
+	})
+	DoTest(t, DeparseTest{
+		Query:    `CREATE TABLE t (a char, b character(3), c varchar(20), d character varying, e bit(4), f bit varying(8));`,
+		Expected: `CREATE TABLE "t" (a char(1), b char(3), c varchar(20), d varchar, e bit(4), f bit varying(8))`,
+	})
+	DoTest(t, DeparseTest{
+		Query:    `CREATE TABLE t (a time, b time(3) with time zone, c timestamp(0), d timestamp with time zone);`,
+		Expected: `CREATE TABLE "t" (a time, b time(3) with time zone, c timestamp(0), d timestamp with time zone)`,
+	})
+}
+
+func Test_TypeName_OtherTypes(t *testing.T) {
+	DoTest(t, DeparseTest{
+		Query:    `CREATE TABLE t (a json, b jsonb, c uuid, d text, e bytea, f inet, g date);`,
+		Expected: `CREATE TABLE "t" (a json, b jsonb, c uuid, d text, e bytea, f inet, g date)`,
+	})
+	DoTest(t, DeparseTest{
+		Query:    `SELECT '{}'::pg_catalog.json, 'a'::public."MyType", 'b'::"Mood";`,
+		Expected: `SELECT '{}'::pg_catalog.json, 'a'::public."MyType", 'b'::"Mood"`,
+	})
+}
+
+func Test_TypeName_Interval(t *testing.T) {
+	DoTest(t, DeparseTest{
+		Query:    `CREATE TABLE t (a interval, b interval(3), c interval year, d interval hour to second(3), e interval day to minute, f interval second(2));`,
+		Expected: `CREATE TABLE "t" (a interval, b interval(3), c interval year, d interval hour to second(3), e interval day to minute, f interval second(2))`,
+	})
+}
+
+func Test_TypeName_Arrays(t *testing.T) {
+	DoTest(t, DeparseTest{
+		Query:    `CREATE TABLE t (a int[], b text[3][], c varchar(10)[]);`,
+		Expected: `CREATE TABLE "t" (a int[], b text[3][], c varchar(10)[])`,
+	})
+}
+
+func Test_TypeName_PctTypeAndSetof(t *testing.T) {
+	DoTest(t, DeparseTest{
+		Query:    `CREATE FUNCTION f(a users.email%TYPE) RETURNS SETOF users LANGUAGE sql AS $$SELECT * FROM users$$;`,
+		Expected: `CREATE FUNCTION f(a users.email%TYPE) RETURNS SETOF users LANGUAGE sql AS $$SELECT * FROM users$$`,
+	})
+}
+
+func Test_TypeName_KeywordNames(t *testing.T) {
+	DoTest(t, DeparseTest{
+		Query:    `CREATE TABLE t (a "any", b "char", c char, d pg_catalog.bpchar, e "Mixed Case", f public."user");`,
+		Expected: `CREATE TABLE "t" (a "any", b "char", c char(1), d bpchar, e "Mixed Case", f public."user")`,
+	})
+}
@@ -2,6 +2,18 @@
 
 package pg_query
 
+import (
+	"strings"
+)
+
 func (node UnlistenStmt) Deparse(ctx Context) (*string, error) {
-	panic("Not Implemented")
+	out := []string{"UNLISTEN"}
+	if node.Conditionname == nil {
+		out = append(out, "*")
+	} else {
+		out = append(out, quoteIdentifier(*node.Conditionname))
+	}
+
+	result := strings.Join(out, " ")
+	return &result, nil
 }
@@ -0,0 +1,53 @@
+package pg_query
+
+import (
+	"fmt"
+	"reflect"
+
+	"github.com/juju/errors"
+)
+
+// UnsupportedNodeError is returned when a node cannot be deparsed because
+// deparsing it has not been implemented. Callers can use the original query
+// text instead when they encounter it.
+type UnsupportedNodeError struct {
+	// NodeType is the Go type of the node, like pg_query.OpExpr.
+	NodeType string
+
+	// Location is the token location of the node within the original query,
+	// or -1 if the node does not carry a location.
+	Location int
+}
+
+func newUnsupportedNodeError(node Node) *UnsupportedNodeError {
+	err := &UnsupportedNodeError{
+		NodeType: fmt.Sprintf("%T", node),
+		Location: -1,
+	}
+
+	value := reflect.ValueOf(node)
+	if value.Kind() == reflect.Ptr {
+		value = value.Elem()
+	}
+
+	if value.Kind() == reflect.Struct {
+		if location := value.FieldByName("Location"); location.IsValid() && location.Kind() == reflect.Int {
+			err.Location = int(location.Int())
+		}
+	}
+	return err
+}
+
+func (err *UnsupportedNodeError) Error() string {
+	if err.Location < 0 {
+		return fmt.Sprintf("cannot deparse %s, it is not supported", err.NodeType)
+	}
+	return fmt.Sprintf("cannot deparse %s at location %d, it is not supported", err.NodeType, err.Location)
+}
+
+// IsUnsupported reports whether the error was caused by a node that cannot be
+// deparsed.
+func IsUnsupported(err error) bool {
+	_, ok := errors.Cause(err).(*UnsupportedNodeError)
+	return ok
+}
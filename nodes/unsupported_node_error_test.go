@@ -0,0 +1,74 @@
+/*
+ * Copyright (c) 2019 Ready Stock
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+package pg_query
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/juju/errors"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_UnsupportedNode_LockingClause(t *testing.T) {
+	DoTest(t, DeparseTest{
+		Query:                `SELECT * FROM t FOR UPDATE;`,
+		ExpectedCompileError: `cannot deparse pg_query.LockingClause, it is not supported`,
+	})
+}
+
+func Test_UnsupportedNode_Location(t *testing.T) {
+	result, err := Deparse(OpExpr{Location: 7})
+	assert.Nil(t, result)
+	assert.EqualError(t, err, "cannot deparse pg_query.OpExpr at location 7, it is not supported")
+	assert.True(t, IsUnsupported(err), "expected error to be an unsupported node error")
+	assert.True(t, IsUnsupported(errors.Trace(err)), "expected traced error to be an unsupported node error")
+
+	if unsupported, ok := err.(*UnsupportedNodeError); assert.True(t, ok) {
+		assert.Equal(t, "pg_query.OpExpr", unsupported.NodeType)
+		assert.Equal(t, 7, unsupported.Location)
+	}
+}
+
+func Test_UnsupportedNode_Analyzed(t *testing.T) {
+	for _, node := range []Node{WindowFunc{}, WindowClause{}, TableSampleClause{}} {
+		_, err := Deparse(node)
+		assert.Error(t, err)
+		assert.True(t, IsUnsupported(err), "expected %T to return an unsupported node error", node)
+	}
+}
+
+func Test_Deparse_NullFields(t *testing.T) {
+	_, err := CreateForeignServerStmt{}.Deparse(Context_None)
+	assert.EqualError(t, err, "server name cannot be null in create server")
+	assert.False(t, IsUnsupported(err))
+
+	_, err = RenameStmt{RenameType: OBJECT_COLUMN, RelationType: OBJECT_TABLE, Relation: &RangeVar{}}.Deparse(Context_None)
+	assert.EqualError(t, err, "relation name cannot be null")
+
+	_, err = RenameStmt{RenameType: OBJECT_COLUMN, RelationType: OBJECT_TABLE, Relation: &RangeVar{Relname: new(string)}}.Deparse(Context_None)
+	assert.EqualError(t, err, fmt.Sprintf("name cannot be null when renaming object type [%s]", OBJECT_COLUMN.String()))
+
+	_, err = CreateStmt{}.Deparse(Context_None)
+	assert.EqualError(t, err, "relation cannot be null in create table")
+
+	_, err = SelectStmt{FromClause: List{Items: []Node{RangeVar{Relname: new(string), Alias: &Alias{}}}}}.Deparse(Context_None)
+	assert.EqualError(t, err, "alias name cannot be null")
+
+	_, err = Deparse(nil)
+	assert.EqualError(t, err, "cannot deparse a null node")
+}
@@ -3,6 +3,7 @@
 package pg_query
 
 import (
+	"fmt"
 	"github.com/juju/errors"
 	"strings"
 )
@@ -36,12 +37,10 @@ func (node UpdateStmt) Deparse(ctx Context) (*string, error) {
 
 	if node.TargetList.Items != nil && len(node.TargetList.Items) > 0 {
 		out = append(out, "SET")
-		for _, target := range node.TargetList.Items {
-			if str, err := target.Deparse(Context_Update); err != nil {
-				return nil, err
-			} else {
-				out = append(out, *str)
-			}
+		if str, err := deparseUpdateTargets(node.TargetList); err != nil {
+			return nil, err
+		} else {
+			out = append(out, *str)
 		}
 	}
 
@@ -70,3 +69,55 @@ func (node UpdateStmt) Deparse(ctx Context) (*string, error) {
 	result := strings.Join(out, " ")
 	return &result, nil
 }
+
+// deparseUpdateTargets returns the assignments of an UPDATE or ON CONFLICT DO
+// UPDATE clause. The parser splits a multiple-column assignment into one
+// target per column, which are combined again here.
+func deparseUpdateTargets(targets List) (*string, error) {
+	out := make([]string, 0)
+	for i := 0; i < len(targets.Items); i++ {
+		target, ok := targets.Items[i].(ResTarget)
+		if !ok {
+			return nil, errors.New("update targets must be res targets")
+		}
+
+		ref, ok := target.Val.(MultiAssignRef)
+		if !ok {
+			if str, err := target.Deparse(Context_Update); err != nil {
+				return nil, err
+			} else {
+				out = append(out, *str)
+			}
+			continue
+		}
+
+		if ref.Ncolumns < 1 || i+ref.Ncolumns > len(targets.Items) {
+			return nil, errors.Errorf("multi assign ref expects (%d) columns", ref.Ncolumns)
+		}
+
+		names := make([]string, ref.Ncolumns)
+		for j := range names {
+			column, ok := targets.Items[i+j].(ResTarget)
+			if !ok || column.Name == nil {
+				return nil, errors.New("multi assign ref columns must be named res targets")
+			}
+
+			if name, err := column.deparseName(); err != nil {
+				return nil, err
+			} else {
+				names[j] = *name
+			}
+		}
+
+		if str, err := ref.Deparse(Context_None); err != nil {
+			return nil, err
+		} else {
+			out = append(out, fmt.Sprintf("(%s) = %s", strings.Join(names, ", "), *str))
+		}
+
+		i += ref.Ncolumns - 1
+	}
+
+	result := strings.Join(out, ", ")
+	return &result, nil
+}
@@ -23,7 +23,6 @@ import (
 	"github.com/readystock/golog"
 	"github.com/readystock/pg_query_go/parser"
 	"github.com/stretchr/testify/assert"
-	"runtime/debug"
 	"testing"
 )
 
@@ -76,8 +75,11 @@ func (input parsetreeList) Fingerprint() string {
 func parse(input string, log bool) (t *parsetreeList, errr error) {
 	defer func() {
 		if r := recover(); r != nil {
-			debug.PrintStack()
-			errr = r.(error)
+			if e, ok := r.(error); ok {
+				errr = e
+			} else {
+				errr = fmt.Errorf("%v", r)
+			}
 		}
 	}()
 	jsonTree, err := parser.ParseToJSON(input)
@@ -113,6 +115,7 @@ func DoTest(t *testing.T, test DeparseTest) {
 	recompiled, err := ast.Statements[0].Deparse(Context_None)
 	if test.ExpectedCompileError != "" {
 		assert.EqualError(t, err, test.ExpectedCompileError, "did not receive the expected error when recompiling")
+		return
 	} else {
 		if !assert.NoError(t, err, "received an unexpected error while recompiling query") {
 			t.FailNow()
@@ -13,12 +13,12 @@ package pg_query
 type VacuumOption uint
 
 const (
-	VACOPT_VACUUM VacuumOption = iota
-	VACOPT_ANALYZE
-	VACOPT_VERBOSE
-	VACOPT_FREEZE
-	VACOPT_FULL
-	VACOPT_NOWAIT
-	VACOPT_SKIPTOAST
-	VACOPT_DISABLE_PAGE_SKIPPING
+	VACOPT_VACUUM                VacuumOption = 1 << 0 /* do VACUUM */
+	VACOPT_ANALYZE               VacuumOption = 1 << 1 /* do ANALYZE */
+	VACOPT_VERBOSE               VacuumOption = 1 << 2 /* print progress info */
+	VACOPT_FREEZE                VacuumOption = 1 << 3 /* FREEZE option */
+	VACOPT_FULL                  VacuumOption = 1 << 4 /* FULL (non-concurrent) vacuum */
+	VACOPT_NOWAIT                VacuumOption = 1 << 5 /* don't wait to get lock (autovacuum only) */
+	VACOPT_SKIPTOAST             VacuumOption = 1 << 6 /* don't process the TOAST table, if any */
+	VACOPT_DISABLE_PAGE_SKIPPING VacuumOption = 1 << 7 /* don't skip any pages */
 )
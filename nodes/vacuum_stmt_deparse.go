@@ -2,6 +2,64 @@
 
 package pg_query
 
+import (
+	"fmt"
+	"strings"
+)
+
+var (
+	vacuumOptions = []struct {
+		option VacuumOption
+		name   string
+	}{
+		{VACOPT_FULL, "FULL"},
+		{VACOPT_FREEZE, "FREEZE"},
+		{VACOPT_VERBOSE, "VERBOSE"},
+		{VACOPT_ANALYZE, "ANALYZE"},
+		{VACOPT_DISABLE_PAGE_SKIPPING, "DISABLE_PAGE_SKIPPING"},
+	}
+)
+
 func (node VacuumStmt) Deparse(ctx Context) (*string, error) {
-	panic("Not Implemented")
+	out := make([]string, 0)
+	options := VacuumOption(node.Options)
+
+	// ANALYZE can be run on its own, in which case VERBOSE is the only option.
+	if options&VACOPT_VACUUM == 0 {
+		out = append(out, "ANALYZE")
+		if options&VACOPT_VERBOSE != 0 {
+			out = append(out, "VERBOSE")
+		}
+	} else {
+		out = append(out, "VACUUM")
+		names := make([]string, 0)
+		for _, option := range vacuumOptions {
+			if options&option.option != 0 {
+				names = append(names, option.name)
+			}
+		}
+
+		if len(names) > 0 {
+			out = append(out, fmt.Sprintf("(%s)", strings.Join(names, ", ")))
+		}
+	}
+
+	if node.Relation != nil {
+		if str, err := deparseNode(*node.Relation, Context_None); err != nil {
+			return nil, err
+		} else {
+			out = append(out, *str)
+		}
+	}
+
+	if node.VaCols.Items != nil && len(node.VaCols.Items) > 0 {
+		if columns, err := node.VaCols.DeparseList(Context_None); err != nil {
+			return nil, err
+		} else {
+			out = append(out, fmt.Sprintf("(%s)", strings.Join(columns, ", ")))
+		}
+	}
+
+	result := strings.Join(out, " ")
+	return &result, nil
 }
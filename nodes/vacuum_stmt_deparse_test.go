@@ -0,0 +1,154 @@
+/*
+ * Copyright (c) 2019 Ready Stock
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+package pg_query
+
+import (
+	"testing"
+)
+
+func Test_VacuumStmt(t *testing.T) {
+	DoTest(t, DeparseTest{
+		Query:    `VACUUM;`,
+		Expected: `VACUUM`,
+	})
+}
+
+func Test_VacuumStmt_Options(t *testing.T) {
+	DoTest(t, DeparseTest{
+		Query:    `VACUUM (FULL, FREEZE, VERBOSE, ANALYZE, DISABLE_PAGE_SKIPPING) vaccluster (i, j);`,
+		Expected: `VACUUM (FULL, FREEZE, VERBOSE, ANALYZE, DISABLE_PAGE_SKIPPING) "vaccluster" ("i", "j")`,
+	})
+}
+
+func Test_VacuumStmt_Full(t *testing.T) {
+	DoTest(t, DeparseTest{
+		Query:    `VACUUM FULL vactst;`,
+		Expected: `VACUUM (FULL) "vactst"`,
+	})
+}
+
+func Test_VacuumStmt_Analyze(t *testing.T) {
+	DoTest(t, DeparseTest{
+		Query:    `ANALYZE VERBOSE vacparted (a);`,
+		Expected: `ANALYZE VERBOSE "vacparted" ("a")`,
+	})
+}
+
+func Test_ClusterStmt(t *testing.T) {
+	DoTest(t, DeparseTest{
+		Query:    `CLUSTER VERBOSE clstr_tst USING clstr_tst_c;`,
+		Expected: `CLUSTER VERBOSE "clstr_tst" USING clstr_tst_c`,
+	})
+}
+
+func Test_ClusterStmt_All(t *testing.T) {
+	DoTest(t, DeparseTest{
+		Query:    `CLUSTER;`,
+		Expected: `CLUSTER`,
+	})
+}
+
+func Test_ReindexStmt(t *testing.T) {
+	DoTest(t, DeparseTest{
+		Query:    `REINDEX (VERBOSE) TABLE reindex_verbose;`,
+		Expected: `REINDEX (VERBOSE) TABLE "reindex_verbose"`,
+	})
+}
+
+func Test_ReindexStmt_Schema(t *testing.T) {
+	DoTest(t, DeparseTest{
+		Query:    `REINDEX SCHEMA schema_to_reindex;`,
+		Expected: `REINDEX SCHEMA schema_to_reindex`,
+	})
+}
+
+func Test_TruncateStmt(t *testing.T) {
+	DoTest(t, DeparseTest{
+		Query:    `TRUNCATE ONLY truncate_a, trunc_b RESTART IDENTITY CASCADE;`,
+		Expected: `TRUNCATE ONLY "truncate_a", "trunc_b" RESTART IDENTITY CASCADE`,
+	})
+}
+
+func Test_LockStmt(t *testing.T) {
+	DoTest(t, DeparseTest{
+		Query:    `LOCK TABLE lock_tbl1 IN ROW EXCLUSIVE MODE NOWAIT;`,
+		Expected: `LOCK TABLE "lock_tbl1" IN ROW EXCLUSIVE MODE NOWAIT`,
+	})
+}
+
+func Test_LockStmt_Default(t *testing.T) {
+	DoTest(t, DeparseTest{
+		Query:    `LOCK lock_tbl1, lock_tbl2;`,
+		Expected: `LOCK TABLE "lock_tbl1", "lock_tbl2" IN ACCESS EXCLUSIVE MODE`,
+	})
+}
+
+func Test_LockStmt_ShareUpdateExclusive(t *testing.T) {
+	DoTest(t, DeparseTest{
+		Query:    `LOCK TABLE ONLY lock_tbl1 IN SHARE UPDATE EXCLUSIVE MODE;`,
+		Expected: `LOCK TABLE ONLY "lock_tbl1" IN SHARE UPDATE EXCLUSIVE MODE`,
+	})
+}
+
+func Test_CheckPointStmt(t *testing.T) {
+	DoTest(t, DeparseTest{
+		Query:    `CHECKPOINT;`,
+		Expected: `CHECKPOINT`,
+	})
+}
+
+func Test_LoadStmt(t *testing.T) {
+	DoTest(t, DeparseTest{
+		Query:    `LOAD 'plpgsql';`,
+		Expected: `LOAD 'plpgsql'`,
+	})
+}
+
+func Test_ExplainStmt(t *testing.T) {
+	DoTest(t, DeparseTest{
+		Query:    `EXPLAIN (ANALYZE, BUFFERS, FORMAT JSON) SELECT * FROM tenk1 WHERE unique1 = 1;`,
+		Expected: `EXPLAIN (analyze, buffers, format 'json') SELECT * FROM "tenk1" WHERE "unique1" = 1`,
+	})
+}
+
+func Test_ExplainStmt_Verbose(t *testing.T) {
+	DoTest(t, DeparseTest{
+		Query:    `EXPLAIN ANALYZE VERBOSE SELECT 1;`,
+		Expected: `EXPLAIN (analyze, verbose) SELECT 1`,
+	})
+}
+
+func Test_ExplainStmt_Plain(t *testing.T) {
+	DoTest(t, DeparseTest{
+		Query:    `EXPLAIN DELETE FROM t;`,
+		Expected: `EXPLAIN DELETE FROM "t"`,
+	})
+}
+
+func Test_ClusterStmt_QuotedIndex(t *testing.T) {
+	DoTest(t, DeparseTest{
+		Query:    `CLUSTER "T" USING "Idx"`,
+		Expected: `CLUSTER "T" USING "Idx"`,
+	})
+}
+
+func Test_ReindexStmt_QuotedName(t *testing.T) {
+	DoTest(t, DeparseTest{
+		Query:    `REINDEX SCHEMA "S"`,
+		Expected: `REINDEX SCHEMA "S"`,
+	})
+}
@@ -3,20 +3,43 @@
 package pg_query
 
 import (
+	"github.com/juju/errors"
 	"strings"
 )
 
 func (node VariableSetStmt) Deparse(ctx Context) (*string, error) {
-	out := []string{"SET"}
-	if node.IsLocal {
-		out = append(out, "LOCAL")
+	if node.Kind != VAR_RESET_ALL && node.Name == nil {
+		return nil, errors.New("variable name cannot be null in set")
 	}
-	out = append(out, *node.Name)
-	out = append(out, "TO")
-	if args, err := deparseNodeList(node.Args.Items, Context_None); err != nil {
-		return nil, err
-	} else {
-		out = append(out, args...)
+
+	out := make([]string, 0)
+	switch node.Kind {
+	case VAR_RESET:
+		out = append(out, "RESET", *node.Name)
+	case VAR_RESET_ALL:
+		out = append(out, "RESET ALL")
+	default:
+		out = append(out, "SET")
+		if node.IsLocal {
+			out = append(out, "LOCAL")
+		}
+		out = append(out, *node.Name)
+
+		switch node.Kind {
+		case VAR_SET_VALUE:
+			out = append(out, "TO")
+			if args, err := deparseNodeList(node.Args.Items, Context_None); err != nil {
+				return nil, err
+			} else {
+				out = append(out, strings.Join(args, ", "))
+			}
+		case VAR_SET_DEFAULT:
+			out = append(out, "TO DEFAULT")
+		case VAR_SET_CURRENT:
+			out = append(out, "FROM CURRENT")
+		default:
+			return nil, errors.Errorf("cannot deparse variable set kind (%d)", node.Kind)
+		}
 	}
 	result := strings.Join(out, " ")
 	return &result, nil
@@ -4,9 +4,15 @@ package pg_query
 
 import (
 	"strings"
+
+	"github.com/juju/errors"
 )
 
 func (node VariableShowStmt) Deparse(ctx Context) (*string, error) {
+	if node.Name == nil {
+		return nil, errors.New("variable name cannot be null in show")
+	}
+
 	out := []string{"SHOW"}
 	out = append(out, *node.Name)
 	result := strings.Join(out, " ")
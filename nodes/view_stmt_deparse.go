@@ -2,6 +2,98 @@
 
 package pg_query
 
+import (
+	"fmt"
+	"strings"
+
+	"github.com/juju/errors"
+)
+
+var (
+	viewCheckOptions = map[ViewCheckOption]string{
+		NO_CHECK_OPTION:       "",
+		LOCAL_CHECK_OPTION:    "WITH LOCAL CHECK OPTION",
+		CASCADED_CHECK_OPTION: "WITH CASCADED CHECK OPTION",
+	}
+)
+
 func (node ViewStmt) Deparse(ctx Context) (*string, error) {
-	panic("Not Implemented")
+	out := []string{"CREATE"}
+	if node.Replace {
+		out = append(out, "OR REPLACE")
+	}
+
+	if node.View == nil {
+		return nil, errors.New("view statement must have a view")
+	}
+
+	if persistence := node.View.relPersistence(); persistence != nil {
+		out = append(out, *persistence)
+	}
+
+	query := node.Query
+	if recursive := node.recursiveQuery(); recursive != nil {
+		out = append(out, "RECURSIVE")
+		query = recursive
+	}
+
+	out = append(out, "VIEW")
+
+	if str, err := node.View.Deparse(Context_None); err != nil {
+		return nil, err
+	} else {
+		out = append(out, *str)
+	}
+
+	if node.Aliases.Items != nil && len(node.Aliases.Items) > 0 {
+		if aliases, err := node.Aliases.DeparseList(Context_None); err != nil {
+			return nil, err
+		} else {
+			out = append(out, fmt.Sprintf("(%s)", strings.Join(aliases, ", ")))
+		}
+	}
+
+	if node.Options.Items != nil && len(node.Options.Items) > 0 {
+		if str, err := deparseRelOptions(node.Options); err != nil {
+			return nil, err
+		} else {
+			out = append(out, fmt.Sprintf("WITH %s", *str))
+		}
+	}
+
+	if str, err := deparseNode(query, Context_None); err != nil {
+		return nil, err
+	} else {
+		out = append(out, "AS", *str)
+	}
+
+	if option, ok := viewCheckOptions[node.WithCheckOption]; !ok {
+		return nil, errors.Errorf("cannot deparse view check option (%d)", node.WithCheckOption)
+	} else if option != "" {
+		out = append(out, option)
+	}
+
+	result := strings.Join(out, " ")
+	return &result, nil
+}
+
+// recursiveQuery returns the original query of a CREATE RECURSIVE VIEW. The
+// parser rewrites those views into a WITH RECURSIVE query named after the view
+// that selects from itself, none of which has a location in the source.
+func (node ViewStmt) recursiveQuery() Node {
+	stmt, ok := node.Query.(SelectStmt)
+	if !ok || stmt.WithClause == nil || !stmt.WithClause.Recursive || stmt.WithClause.Location != -1 {
+		return nil
+	}
+
+	if len(stmt.WithClause.Ctes.Items) != 1 {
+		return nil
+	}
+
+	cte, ok := stmt.WithClause.Ctes.Items[0].(CommonTableExpr)
+	if !ok || cte.Location != -1 || cte.Ctename == nil || *cte.Ctename != *node.View.Relname {
+		return nil
+	}
+
+	return cte.Ctequery
 }
@@ -0,0 +1,75 @@
+/*
+ * Copyright (c) 2019 Ready Stock
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+package pg_query
+
+import (
+	"testing"
+)
+
+func Test_ViewStmt_Simple(t *testing.T) {
+	DoTest(t, DeparseTest{
+		Query:    `CREATE VIEW active_users AS SELECT id FROM users WHERE active;`,
+		Expected: `CREATE VIEW "active_users" AS SELECT "id" FROM "users" WHERE "active"`,
+	})
+}
+
+func Test_ViewStmt_Options(t *testing.T) {
+	DoTest(t, DeparseTest{
+		Query:    `CREATE OR REPLACE TEMP VIEW v (a, b) WITH (security_barrier) AS SELECT id, email FROM users WITH CASCADED CHECK OPTION;`,
+		Expected: `CREATE OR REPLACE TEMPORARY VIEW "v" ("a", "b") WITH (security_barrier) AS SELECT "id", "email" FROM "users" WITH CASCADED CHECK OPTION`,
+	})
+	DoTest(t, DeparseTest{
+		Query:    `CREATE VIEW v AS SELECT id FROM users WITH LOCAL CHECK OPTION;`,
+		Expected: `CREATE VIEW "v" AS SELECT "id" FROM "users" WITH LOCAL CHECK OPTION`,
+	})
+}
+
+func Test_ViewStmt_Recursive(t *testing.T) {
+	DoTest(t, DeparseTest{
+		Query:    `CREATE RECURSIVE VIEW nums (n) AS SELECT 1;`,
+		Expected: `CREATE RECURSIVE VIEW "nums" ("n") AS SELECT 1`,
+	})
+}
+
+func Test_CreateTableAsStmt_Table(t *testing.T) {
+	DoTest(t, DeparseTest{
+		Query:    `CREATE TABLE IF NOT EXISTS users_copy (a, b) AS SELECT id, email FROM users;`,
+		Expected: `CREATE TABLE IF NOT EXISTS "users_copy" ("a", "b") AS SELECT "id", "email" FROM "users"`,
+	})
+	DoTest(t, DeparseTest{
+		Query:    `CREATE TEMP TABLE t ON COMMIT DROP AS SELECT 1;`,
+		Expected: `CREATE TEMPORARY TABLE "t" ON COMMIT DROP AS SELECT 1`,
+	})
+}
+
+func Test_CreateTableAsStmt_MaterializedView(t *testing.T) {
+	DoTest(t, DeparseTest{
+		Query:    `CREATE MATERIALIZED VIEW m WITH (fillfactor=70) TABLESPACE fast AS SELECT id FROM users WITH NO DATA;`,
+		Expected: `CREATE MATERIALIZED VIEW "m" WITH (fillfactor=70) TABLESPACE "fast" AS SELECT "id" FROM "users" WITH NO DATA`,
+	})
+}
+
+func Test_RefreshMatViewStmt(t *testing.T) {
+	DoTest(t, DeparseTest{
+		Query:    `REFRESH MATERIALIZED VIEW CONCURRENTLY m;`,
+		Expected: `REFRESH MATERIALIZED VIEW CONCURRENTLY "m"`,
+	})
+	DoTest(t, DeparseTest{
+		Query:    `REFRESH MATERIALIZED VIEW public.m WITH NO DATA;`,
+		Expected: `REFRESH MATERIALIZED VIEW "public"."m" WITH NO DATA`,
+	})
+}
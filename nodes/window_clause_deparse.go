@@ -2,6 +2,8 @@
 
 package pg_query
 
+// WindowClause nodes are only produced by parse analysis, they never appear in
+// raw parse trees and refer to entries of the query's target list by index.
 func (node WindowClause) Deparse(ctx Context) (*string, error) {
-	panic("Not Implemented")
+	return nil, newUnsupportedNodeError(node)
 }
@@ -2,6 +2,117 @@
 
 package pg_query
 
+import (
+	"fmt"
+	"strings"
+
+	"github.com/juju/errors"
+)
+
+// Deparse returns the window specification without its surrounding
+// parentheses, since OVER and WINDOW clauses wrap it differently.
 func (node WindowDef) Deparse(ctx Context) (*string, error) {
-	panic("Not Implemented")
+	out := make([]string, 0)
+	if node.Refname != nil {
+		out = append(out, quoteIdentifier(*node.Refname))
+	}
+
+	if node.PartitionClause.Items != nil && len(node.PartitionClause.Items) > 0 {
+		if items, err := node.PartitionClause.DeparseList(Context_None); err != nil {
+			return nil, err
+		} else {
+			out = append(out, fmt.Sprintf("PARTITION BY %s", strings.Join(items, ", ")))
+		}
+	}
+
+	if node.OrderClause.Items != nil && len(node.OrderClause.Items) > 0 {
+		if items, err := node.OrderClause.DeparseList(Context_None); err != nil {
+			return nil, err
+		} else {
+			out = append(out, fmt.Sprintf("ORDER BY %s", strings.Join(items, ", ")))
+		}
+	}
+
+	if str, err := deparseFrameOptions(node.FrameOptions, node.StartOffset, node.EndOffset); err != nil {
+		return nil, err
+	} else if str != nil {
+		out = append(out, *str)
+	}
+
+	result := strings.Join(out, " ")
+	return &result, nil
+}
+
+// deparseFrameOptions returns the frame clause described by the frame option
+// bits, or nil when the default frame is used.
+func deparseFrameOptions(options int, startOffset Node, endOffset Node) (*string, error) {
+	if options&FRAMEOPTION_NONDEFAULT == 0 {
+		return nil, nil
+	}
+
+	out := make([]string, 0)
+	if options&FRAMEOPTION_ROWS != 0 {
+		out = append(out, "ROWS")
+	} else {
+		out = append(out, "RANGE")
+	}
+
+	start, err := deparseFrameBound(options, startOffset, true)
+	if err != nil {
+		return nil, err
+	}
+
+	if options&FRAMEOPTION_BETWEEN != 0 {
+		end, err := deparseFrameBound(options, endOffset, false)
+		if err != nil {
+			return nil, err
+		}
+
+		out = append(out, "BETWEEN", *start, "AND", *end)
+	} else {
+		out = append(out, *start)
+	}
+
+	result := strings.Join(out, " ")
+	return &result, nil
+}
+
+func deparseFrameBound(options int, offset Node, start bool) (*string, error) {
+	unboundedPreceding, unboundedFollowing := FRAMEOPTION_END_UNBOUNDED_PRECEDING, FRAMEOPTION_END_UNBOUNDED_FOLLOWING
+	currentRow := FRAMEOPTION_END_CURRENT_ROW
+	valuePreceding, valueFollowing := FRAMEOPTION_END_VALUE_PRECEDING, FRAMEOPTION_END_VALUE_FOLLOWING
+	if start {
+		unboundedPreceding, unboundedFollowing = FRAMEOPTION_START_UNBOUNDED_PRECEDING, FRAMEOPTION_START_UNBOUNDED_FOLLOWING
+		currentRow = FRAMEOPTION_START_CURRENT_ROW
+		valuePreceding, valueFollowing = FRAMEOPTION_START_VALUE_PRECEDING, FRAMEOPTION_START_VALUE_FOLLOWING
+	}
+
+	result := ""
+	switch {
+	case options&unboundedPreceding != 0:
+		result = "UNBOUNDED PRECEDING"
+	case options&unboundedFollowing != 0:
+		result = "UNBOUNDED FOLLOWING"
+	case options&currentRow != 0:
+		result = "CURRENT ROW"
+	case options&(valuePreceding|valueFollowing) != 0:
+		if offset == nil {
+			return nil, errors.New("frame bound must have an offset")
+		}
+
+		str, err := deparseNode(offset, Context_None)
+		if err != nil {
+			return nil, err
+		}
+
+		if options&valuePreceding != 0 {
+			result = fmt.Sprintf("%s PRECEDING", *str)
+		} else {
+			result = fmt.Sprintf("%s FOLLOWING", *str)
+		}
+	default:
+		return nil, errors.Errorf("cannot deparse frame bound of frame options (%d)", options)
+	}
+
+	return &result, nil
 }
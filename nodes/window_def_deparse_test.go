@@ -0,0 +1,103 @@
+/*
+ * Copyright (c) 2019 Ready Stock
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+package pg_query
+
+import (
+	"testing"
+)
+
+func Test_WindowDef_Over(t *testing.T) {
+	DoTest(t, DeparseTest{
+		Query:    `SELECT depname, empno, salary, rank() OVER (PARTITION BY depname ORDER BY salary) FROM empsalary;`,
+		Expected: `SELECT "depname", "empno", "salary", rank() OVER (PARTITION BY "depname" ORDER BY "salary") FROM "empsalary"`,
+	})
+	DoTest(t, DeparseTest{
+		Query:    `SELECT COUNT(*) OVER () FROM tenk1 WHERE unique2 < 10;`,
+		Expected: `SELECT count(*) OVER () FROM "tenk1" WHERE "unique2" < 10`,
+	})
+}
+
+func Test_WindowDef_WindowClause(t *testing.T) {
+	DoTest(t, DeparseTest{
+		Query:    `SELECT sum(salary) OVER w, rank() OVER w FROM empsalary WINDOW w AS (PARTITION BY depname ORDER BY salary DESC);`,
+		Expected: `SELECT sum("salary") OVER w, rank() OVER w FROM "empsalary" WINDOW w AS (PARTITION BY "depname" ORDER BY "salary" DESC)`,
+	})
+	DoTest(t, DeparseTest{
+		Query:    `SELECT sum(unique1) over (w range between current row and unbounded following) FROM tenk1 WINDOW w AS (order by four);`,
+		Expected: `SELECT sum("unique1") OVER (w RANGE BETWEEN CURRENT ROW AND UNBOUNDED FOLLOWING) FROM "tenk1" WINDOW w AS (ORDER BY "four")`,
+	})
+	DoTest(t, DeparseTest{
+		Query:    `SELECT COUNT(*) OVER w FROM tenk1 WHERE unique2 < 10 WINDOW w AS ();`,
+		Expected: `SELECT count(*) OVER w FROM "tenk1" WHERE "unique2" < 10 WINDOW w AS ()`,
+	})
+}
+
+func Test_WindowDef_Frames(t *testing.T) {
+	DoTest(t, DeparseTest{
+		Query:    `SELECT sum(unique1) over (rows between unbounded preceding and current row) FROM tenk1;`,
+		Expected: `SELECT sum("unique1") OVER (ROWS BETWEEN UNBOUNDED PRECEDING AND CURRENT ROW) FROM "tenk1"`,
+	})
+	DoTest(t, DeparseTest{
+		Query:    `SELECT sum(unique1) over (rows between 2 preceding and 2 following) FROM tenk1;`,
+		Expected: `SELECT sum("unique1") OVER (ROWS BETWEEN 2 PRECEDING AND 2 FOLLOWING) FROM "tenk1"`,
+	})
+	DoTest(t, DeparseTest{
+		Query:    `SELECT sum(unique1) over (order by four rows 1 preceding) FROM tenk1;`,
+		Expected: `SELECT sum("unique1") OVER (ORDER BY "four" ROWS 1 PRECEDING) FROM "tenk1"`,
+	})
+	DoTest(t, DeparseTest{
+		Query:    `SELECT sum(unique1) over (order by four nulls first range unbounded preceding) FROM tenk1;`,
+		Expected: `SELECT sum("unique1") OVER (ORDER BY "four" NULLS FIRST RANGE UNBOUNDED PRECEDING) FROM "tenk1"`,
+	})
+}
+
+func Test_FuncCall_AggregateClauses(t *testing.T) {
+	DoTest(t, DeparseTest{
+		Query:    `SELECT count(*) FILTER (WHERE four > 1) FROM tenk1;`,
+		Expected: `SELECT count(*) FILTER (WHERE "four" > 1) FROM "tenk1"`,
+	})
+	DoTest(t, DeparseTest{
+		Query:    `SELECT array_agg(DISTINCT ten ORDER BY ten DESC) FROM tenk1;`,
+		Expected: `SELECT array_agg(DISTINCT "ten" ORDER BY "ten" DESC) FROM "tenk1"`,
+	})
+	DoTest(t, DeparseTest{
+		Query:    `SELECT percentile_cont(0.5) WITHIN GROUP (ORDER BY salary) FILTER (WHERE salary > 0) FROM empsalary;`,
+		Expected: `SELECT percentile_cont(0.5) WITHIN GROUP (ORDER BY "salary") FILTER (WHERE "salary" > 0) FROM "empsalary"`,
+	})
+	DoTest(t, DeparseTest{
+		Query:    `SELECT sum(salary) FILTER (WHERE salary > 0) OVER (PARTITION BY depname) FROM empsalary;`,
+		Expected: `SELECT sum("salary") FILTER (WHERE "salary" > 0) OVER (PARTITION BY "depname") FROM "empsalary"`,
+	})
+}
+
+func Test_FuncCall_Variadic(t *testing.T) {
+	DoTest(t, DeparseTest{
+		Query:    `SELECT concat_ws(',', VARIADIC parts) FROM t;`,
+		Expected: `SELECT concat_ws(',', VARIADIC "parts") FROM "t"`,
+	})
+}
+
+func Test_WindowDef_QuotedNames(t *testing.T) {
+	DoTest(t, DeparseTest{
+		Query:    `SELECT sum(salary) OVER "order" FROM empsalary WINDOW "order" AS (ORDER BY salary);`,
+		Expected: `SELECT sum("salary") OVER "order" FROM "empsalary" WINDOW "order" AS (ORDER BY "salary")`,
+	})
+	DoTest(t, DeparseTest{
+		Query:    `SELECT rank() OVER ("W" ORDER BY salary) FROM empsalary WINDOW "W" AS (PARTITION BY depname);`,
+		Expected: `SELECT rank() OVER ("W" ORDER BY "salary") FROM "empsalary" WINDOW "W" AS (PARTITION BY "depname")`,
+	})
+}
@@ -2,6 +2,8 @@
 
 package pg_query
 
+// WindowFunc nodes are only produced by parse analysis, they never appear in
+// raw parse trees and reference catalog entries that cannot be resolved here.
 func (node WindowFunc) Deparse(ctx Context) (*string, error) {
-	panic("Not Implemented")
+	return nil, newUnsupportedNodeError(node)
 }
@@ -2,6 +2,121 @@
 
 package pg_query
 
+import (
+	"fmt"
+	"strings"
+
+	"github.com/juju/errors"
+)
+
+var (
+	xmlOptionTypes = map[XmlOptionType]string{
+		XMLOPTION_DOCUMENT: "DOCUMENT",
+		XMLOPTION_CONTENT:  "CONTENT",
+	}
+
+	xmlStandaloneTypes = map[XmlStandaloneType]string{
+		XML_STANDALONE_YES:      "STANDALONE YES",
+		XML_STANDALONE_NO:       "STANDALONE NO",
+		XML_STANDALONE_NO_VALUE: "STANDALONE NO VALUE",
+	}
+)
+
 func (node XmlExpr) Deparse(ctx Context) (*string, error) {
-	panic("Not Implemented")
+	args, err := node.Args.DeparseList(Context_None)
+	if err != nil {
+		return nil, err
+	}
+
+	// Attributes and forest elements are written like select targets, with
+	// the name of the element as the alias.
+	namedArgs, err := node.NamedArgs.DeparseList(Context_Select)
+	if err != nil {
+		return nil, err
+	}
+
+	// Elements and processing instructions are the only expressions that are
+	// named, the name is a label and may therefore be any keyword.
+	name := ""
+	if node.Op == IS_XMLELEMENT || node.Op == IS_XMLPI {
+		if node.Name == nil {
+			return nil, errors.New("xml element and processing instruction must have a name")
+		}
+		name = fmt.Sprintf("name %s", quoteLabel(*node.Name))
+	}
+
+	result := ""
+	switch node.Op {
+	case IS_XMLCONCAT:
+		result = fmt.Sprintf("xmlconcat(%s)", strings.Join(args, ", "))
+	case IS_XMLELEMENT:
+		out := []string{name}
+		if len(namedArgs) > 0 {
+			out = append(out, fmt.Sprintf("xmlattributes(%s)", strings.Join(namedArgs, ", ")))
+		}
+		out = append(out, args...)
+		result = fmt.Sprintf("xmlelement(%s)", strings.Join(out, ", "))
+	case IS_XMLFOREST:
+		result = fmt.Sprintf("xmlforest(%s)", strings.Join(namedArgs, ", "))
+	case IS_XMLPARSE:
+		if len(node.Args.Items) != 2 {
+			return nil, errors.New("xmlparse must have a value and whitespace option")
+		}
+
+		// Whether whitespace is preserved is stored as a boolean cast of 't' or
+		// 'f', stripping it is the default.
+		option, ok := xmlOptionTypes[node.Xmloption]
+		if !ok {
+			return nil, errors.Errorf("cannot deparse xml option (%d)", node.Xmloption)
+		}
+
+		result = fmt.Sprintf("%s %s", option, args[0])
+		if preserve, ok := node.Args.Items[1].(TypeCast); ok {
+			if value, ok := preserve.Arg.(A_Const); ok {
+				if value, ok := value.Val.(String); ok && value.Str == "t" {
+					result = fmt.Sprintf("%s PRESERVE WHITESPACE", result)
+				}
+			}
+		}
+		result = fmt.Sprintf("xmlparse(%s)", result)
+	case IS_XMLPI:
+		out := []string{name}
+		out = append(out, args...)
+		result = fmt.Sprintf("xmlpi(%s)", strings.Join(out, ", "))
+	case IS_XMLROOT:
+		if len(node.Args.Items) != 3 {
+			return nil, errors.New("xmlroot must have a value, version and standalone option")
+		}
+
+		out := []string{args[0]}
+		noVersion := false
+		if version, ok := node.Args.Items[1].(A_Const); ok {
+			_, noVersion = version.Val.(Null)
+		}
+		if noVersion {
+			out = append(out, "version NO VALUE")
+		} else {
+			out = append(out, fmt.Sprintf("version %s", args[1]))
+		}
+
+		standalone, ok := node.Args.Items[2].(A_Const)
+		if !ok {
+			return nil, errors.New("xmlroot standalone option must be a constant")
+		}
+		if value, ok := standalone.Val.(Integer); !ok {
+			return nil, errors.New("xmlroot standalone option must be an integer")
+		} else if option, ok := xmlStandaloneTypes[XmlStandaloneType(value.Ival)]; ok {
+			out = append(out, option)
+		}
+		result = fmt.Sprintf("xmlroot(%s)", strings.Join(out, ", "))
+	case IS_DOCUMENT:
+		if len(args) != 1 {
+			return nil, errors.New("is document must have a single value")
+		}
+		result = fmt.Sprintf("%s IS DOCUMENT", args[0])
+	default:
+		return nil, errors.Errorf("cannot deparse xml expression op (%d)", node.Op)
+	}
+
+	return &result, nil
 }
@@ -0,0 +1,49 @@
+/*
+ * Copyright (c) 2019 Ready Stock
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+package pg_query
+
+import (
+	"testing"
+)
+
+func Test_XmlExpr(t *testing.T) {
+	DoTest(t, DeparseTest{
+		Query:    `SELECT xmlelement(name foo, xmlattributes('x' AS bar, b), 'c'), xmlforest(a, b AS c), xmlconcat('a', 'b'), xmlpi(name php, 'x') FROM t;`,
+		Expected: `SELECT xmlelement(name foo, xmlattributes('x' AS bar, "b"), 'c'), xmlforest("a", "b" AS c), xmlconcat('a', 'b'), xmlpi(name php, 'x') FROM "t"`,
+	})
+}
+
+func Test_XmlExpr_Parse(t *testing.T) {
+	DoTest(t, DeparseTest{
+		Query:    `SELECT xmlparse(document '<x/>' PRESERVE WHITESPACE), xmlparse(content 'x'), xmlroot('<x/>', version '1.0', standalone yes), xmlroot('<x/>', version NO VALUE), x IS DOCUMENT FROM t;`,
+		Expected: `SELECT xmlparse(DOCUMENT '<x/>' PRESERVE WHITESPACE), xmlparse(CONTENT 'x'), xmlroot('<x/>', version '1.0', STANDALONE YES), xmlroot('<x/>', version NO VALUE), "x" IS DOCUMENT FROM "t"`,
+	})
+}
+
+func Test_XmlSerialize(t *testing.T) {
+	DoTest(t, DeparseTest{
+		Query:    `SELECT xmlserialize(content x AS text) FROM t;`,
+		Expected: `SELECT xmlserialize(CONTENT "x" AS text) FROM "t"`,
+	})
+}
+
+func Test_XmlExpr_QuotedNames(t *testing.T) {
+	DoTest(t, DeparseTest{
+		Query:    `SELECT xmlpi(name "xml-stylesheet"), xmlelement(name "Foo", xmlattributes('x' AS "Bar", 1 AS "select")), xmlforest(a AS "A") FROM t`,
+		Expected: `SELECT xmlpi(name "xml-stylesheet"), xmlelement(name "Foo", xmlattributes('x' AS "Bar", 1 AS select)), xmlforest("a" AS "A") FROM "t"`,
+	})
+}
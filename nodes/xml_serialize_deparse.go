@@ -2,6 +2,32 @@
 
 package pg_query
 
+import (
+	"fmt"
+
+	"github.com/juju/errors"
+)
+
 func (node XmlSerialize) Deparse(ctx Context) (*string, error) {
-	panic("Not Implemented")
+	if node.TypeName == nil {
+		return nil, errors.New("type cannot be null in xmlserialize")
+	}
+
+	option, ok := xmlOptionTypes[node.Xmloption]
+	if !ok {
+		return nil, errors.Errorf("cannot deparse xml option (%d)", node.Xmloption)
+	}
+
+	expr, err := deparseNode(node.Expr, Context_None)
+	if err != nil {
+		return nil, err
+	}
+
+	typeName, err := deparseNode(*node.TypeName, Context_None)
+	if err != nil {
+		return nil, err
+	}
+
+	result := fmt.Sprintf("xmlserialize(%s %s AS %s)", option, *expr, *typeName)
+	return &result, nil
 }
@@ -2,8 +2,8 @@ package pg_query
 
 import (
 	"encoding/json"
+	"fmt"
 	"github.com/readystock/pg_query_go/parser"
-	"runtime/debug"
 )
 
 // ParseToJSON - Parses the given SQL statement into an AST (JSON format)
@@ -15,8 +15,11 @@ func ParseToJSON(input string) (result string, err error) {
 func Parse(input string) (tree *ParsetreeList, err error) {
 	defer func() {
 		if r := recover(); r != nil {
-			debug.PrintStack()
-			err = r.(error)
+			if e, ok := r.(error); ok {
+				err = e
+			} else {
+				err = fmt.Errorf("%v", r)
+			}
 		}
 	}()
 	jsonTree, err := ParseToJSON(input)
@@ -507,6 +507,13 @@ func UnmarshalNodeJSON(input json.RawMessage) (node Node, err error) {
 
         go_enum_def = ''
         output_first_type_field = false
+
+        # Enums that assign explicit values, like the characters that are stored
+        # in the catalogs, have to keep those values instead of counting up.
+        explicit_values = enum_def['values'].any? { |field| field['value'] }
+        go_base_type = enum_def['values'].any? { |field| field['value'].to_s.start_with?('-') } ? 'int' : 'uint'
+        last_value = nil
+
         enum_def['values'].each_with_index do |field, index|
           if !field['name'] && field['comment']
             go_enum_def += "\n" if index != 0
@@ -514,7 +521,11 @@ func UnmarshalNodeJSON(input json.RawMessage) (node Node, err error) {
             next
           end
 
-          if !output_first_type_field
+          if explicit_values
+            value = field['value'] || (last_value ? format('%s + 1', last_value) : '0')
+            go_enum_def += format("%s %s = %s %s\n", field['name'], type, value, field['comment'])
+            last_value = value
+          elsif !output_first_type_field
             go_enum_def += format("%s %s = iota %s\n", field['name'], type, field['comment'])
             output_first_type_field = true
           else
@@ -524,7 +535,7 @@ func UnmarshalNodeJSON(input json.RawMessage) (node Node, err error) {
 
         write_nodes_file type, %(
           #{enum_def['comment'] && enum_def['comment'].strip}
-          type #{type} uint
+          type #{type} #{go_base_type}
 
           const (
             #{go_enum_def.strip}
@@ -541,6 +552,31 @@ func UnmarshalNodeJSON(input json.RawMessage) (node Node, err error) {
     end
 
     write_nodes_file('typedefs', typedefs_go)
+
+    generate_keywords!
+  end
+
+  # The deparser has to know which identifiers are keywords, since those must
+  # be quoted unless they are unreserved.
+  def generate_keywords!
+    categories = File.read('./parser/include/common/keywords.h').scan(/^#define (\w+_KEYWORD)\s+(\d+)/)
+    keywords = File.read('./parser/include/parser/kwlist.h').scan(/^PG_KEYWORD\("(\w+)", \w+, (\w+)\)/)
+
+    categories_go = categories.map { |name, value| format("%s = %s\n", name, value) }.join
+    keywords_go = keywords.map { |name, category| format("\"%s\": %s,\n", name, category) }.join
+
+    write_nodes_file 'keywords', %(
+/* Keyword categories */
+const (
+#{categories_go.strip}
+)
+
+// keywordCategories maps each keyword to its category, which determines where
+// it can be used as an identifier without being quoted.
+var keywordCategories = map[string]int{
+#{keywords_go.strip}
+}
+    ), true, 'postgres/src/include/parser/kwlist.h'
   end
 
   def write_nodes_file(name, content, overwrite = true, source_file = nil)